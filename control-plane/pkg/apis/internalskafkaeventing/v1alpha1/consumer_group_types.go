@@ -28,6 +28,8 @@ import (
 
 	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	eventingduckv1alpha1 "knative.dev/eventing/pkg/apis/duck/v1alpha1"
+
+	sources "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/sources/v1beta1"
 )
 
 // +genclient
@@ -150,6 +152,11 @@ type ConsumerGroupStatus struct {
 	// Selector is the string serialized label selector needed for the scale subresource.
 	// Defaults to ""
 	Selector string `json:"selector,omitempty"`
+
+	// Topics are the topics consumed by the consumer group with the range of
+	// offsets available in each of their partitions.
+	// +optional
+	Topics []sources.TopicStatus `json:"topics,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
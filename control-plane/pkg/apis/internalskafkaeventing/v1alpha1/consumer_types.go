@@ -93,6 +93,11 @@ type ConsumerSpec struct {
 	// Subscriber is the addressable that receives events that pass the Filters.
 	Subscriber duckv1.Destination `json:"subscriber"`
 
+	// AuditSink is the addressable that receives a CloudEvent on each rebalance
+	// of the consumer group.
+	// +optional
+	AuditSink *duckv1.Destination `json:"auditSink,omitempty"`
+
 	// CloudEventOverrides defines overrides to control the output format and
 	// modifications of the event sent to the subscriber.
 	// +optional
@@ -161,6 +166,11 @@ type DeliverySpec struct {
 	// InitialOffset initial offset.
 	InitialOffset sources.Offset `json:"initialOffset"`
 
+	// RetryAttemptExtension is the name of the CloudEvent extension set to the
+	// delivery attempt number, empty means no extension.
+	// +optional
+	RetryAttemptExtension string `json:"retryAttemptExtension,omitempty"`
+
 	// TODO Add rate limiting
 
 	// TODO PT OPT
@@ -181,6 +191,14 @@ type ConsumerConfigs struct {
 	//
 	// Default value: string
 	KeyType *string `json:"keyType,omitempty"`
+
+	// rebalance protocol of the consumer group
+	// Possible values:
+	// - "eager"
+	// - "cooperative"
+	//
+	// Default value: eager
+	RebalanceProtocol *string `json:"rebalanceProtocol,omitempty"`
 }
 
 // ConsumerTemplateSpec describes the data a consumer should have when created from a template.
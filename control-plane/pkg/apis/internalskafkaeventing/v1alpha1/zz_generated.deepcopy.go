@@ -26,6 +26,7 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	v1beta1 "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/bindings/v1beta1"
 	eventingv1alpha1 "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/eventing/v1alpha1"
+	sourcesv1beta1 "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/sources/v1beta1"
 	apisduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	apis "knative.dev/pkg/apis"
@@ -132,6 +133,11 @@ func (in *ConsumerConfigs) DeepCopyInto(out *ConsumerConfigs) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RebalanceProtocol != nil {
+		in, out := &in.RebalanceProtocol, &out.RebalanceProtocol
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -271,6 +277,13 @@ func (in *ConsumerGroupStatus) DeepCopyInto(out *ConsumerGroupStatus) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]sourcesv1beta1.TopicStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -347,6 +360,11 @@ func (in *ConsumerSpec) DeepCopyInto(out *ConsumerSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Subscriber.DeepCopyInto(&out.Subscriber)
+	if in.AuditSink != nil {
+		in, out := &in.AuditSink, &out.AuditSink
+		*out = new(duckv1.Destination)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CloudEventOverrides != nil {
 		in, out := &in.CloudEventOverrides, &out.CloudEventOverrides
 		*out = new(duckv1.CloudEventOverrides)
@@ -59,6 +59,11 @@ func (k *KafkaSource) SetDefaults(ctx context.Context) {
 		k.Spec.Ordering = &deliveryOrdering
 	}
 
+	if k.Spec.RebalanceProtocol == nil {
+		rebalanceProtocol := RebalanceProtocolEager
+		k.Spec.RebalanceProtocol = &rebalanceProtocol
+	}
+
 	kafkaConfig := config.FromContextOrDefaults(ctx)
 	kafkaDefaults := kafkaConfig.KafkaSourceDefaults
 	if kafkaDefaults.AutoscalingClass == config.KedaAutoscalingClass {
@@ -77,4 +82,5 @@ func (k *KafkaSource) SetDefaults(ctx context.Context) {
 
 	k.Spec.Sink.SetDefaults(ctx)
 	k.Spec.Delivery.SetDefaults(ctx)
+	k.Spec.AuditSink.SetDefaults(ctx)
 }
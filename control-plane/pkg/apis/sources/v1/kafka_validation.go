@@ -58,6 +58,19 @@ func (kss *KafkaSourceSpec) Validate(ctx context.Context) *apis.FieldError {
 			errs = errs.Also(apis.ErrInvalidValue(*kss.Ordering, "ordering"))
 		}
 	}
+	if kss.RebalanceProtocol != nil {
+		switch *kss.RebalanceProtocol {
+		case RebalanceProtocolEager, RebalanceProtocolCooperative:
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(*kss.RebalanceProtocol, "rebalanceProtocol"))
+		}
+	}
+	if kss.RetryAttemptExtension != nil && !IsValidRetryAttemptExtension(*kss.RetryAttemptExtension) {
+		errs = errs.Also(apis.ErrInvalidValue(*kss.RetryAttemptExtension, "retryAttemptExtension"))
+	}
+	if kss.AuditSink != nil {
+		errs = errs.Also(kss.AuditSink.Validate(ctx).ViaField("auditSink"))
+	}
 
 	return errs
 }
@@ -86,3 +99,23 @@ func (ks *KafkaSource) CheckImmutableFields(ctx context.Context, original *Kafka
 
 	return nil
 }
+
+// IsValidRetryAttemptExtension checks that the name is a valid CloudEvents
+// attribute name, which is made of lowercase letters and digits only, and
+// that it doesn't override a CloudEvents context attribute or one of the
+// extensions set on the events sent to the dead letter sink.
+func IsValidRetryAttemptExtension(name string) bool {
+	switch name {
+	case "",
+		"id", "source", "specversion", "type",
+		"datacontenttype", "dataschema", "subject", "time", "data",
+		"knativeerrordest", "knativeerrorcode", "knativeerrordata":
+		return false
+	}
+	for _, c := range name {
+		if !((c >= 'a' && c <= 'z') || (c >= '0' && c <= '9')) {
+			return false
+		}
+	}
+	return true
+}
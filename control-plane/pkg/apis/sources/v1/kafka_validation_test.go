@@ -33,6 +33,12 @@ func TestKafka_Validate(t *testing.T) {
 	validOrdering := Ordered
 	badOrdering := DeliveryOrdering("badOrder")
 	badInitialOffset := Offset("badbOffset")
+	validRebalanceProtocol := RebalanceProtocolCooperative
+	badRebalanceProtocol := RebalanceProtocol("badProtocol")
+	validRetryAttemptExtension := "knativeerrorattempt"
+	badRetryAttemptExtension := "knative-error-attempt"
+	contextAttributeRetryAttemptExtension := "type"
+	deadLetterRetryAttemptExtension := "knativeerrorcode"
 
 	tests := []struct {
 		name string
@@ -125,6 +131,150 @@ func TestKafka_Validate(t *testing.T) {
 			ctx:  context.Background(),
 			want: apis.ErrInvalidValue(badInitialOffset, "spec.initialOffset"),
 		},
+		{
+			name: "invalid rebalanceProtocol",
+			ks: &KafkaSource{
+				Spec: KafkaSourceSpec{
+					Topics: []string{"test-topic"},
+					KafkaAuthSpec: bindingsv1.KafkaAuthSpec{
+						BootstrapServers: []string{"kafka:9092"},
+					},
+					RebalanceProtocol: &badRebalanceProtocol,
+					ConsumerGroup:     "ks-group",
+					SourceSpec: duckv1.SourceSpec{
+						Sink: NewSourceSinkReference(),
+					},
+				},
+			},
+			ctx:  context.Background(),
+			want: apis.ErrInvalidValue(badRebalanceProtocol, "spec.rebalanceProtocol"),
+		},
+		{
+			name: "valid rebalanceProtocol",
+			ks: &KafkaSource{
+				Spec: KafkaSourceSpec{
+					Topics: []string{"test-topic"},
+					KafkaAuthSpec: bindingsv1.KafkaAuthSpec{
+						BootstrapServers: []string{"kafka:9092"},
+					},
+					RebalanceProtocol: &validRebalanceProtocol,
+					ConsumerGroup:     "ks-group",
+					SourceSpec: duckv1.SourceSpec{
+						Sink: NewSourceSinkReference(),
+					},
+				},
+			},
+			ctx:  context.Background(),
+			want: nil,
+		},
+		{
+			name: "invalid retryAttemptExtension",
+			ks: &KafkaSource{
+				Spec: KafkaSourceSpec{
+					Topics: []string{"test-topic"},
+					KafkaAuthSpec: bindingsv1.KafkaAuthSpec{
+						BootstrapServers: []string{"kafka:9092"},
+					},
+					RetryAttemptExtension: &badRetryAttemptExtension,
+					ConsumerGroup:         "ks-group",
+					SourceSpec: duckv1.SourceSpec{
+						Sink: NewSourceSinkReference(),
+					},
+				},
+			},
+			ctx:  context.Background(),
+			want: apis.ErrInvalidValue(badRetryAttemptExtension, "spec.retryAttemptExtension"),
+		},
+		{
+			name: "retryAttemptExtension overriding a context attribute",
+			ks: &KafkaSource{
+				Spec: KafkaSourceSpec{
+					Topics: []string{"test-topic"},
+					KafkaAuthSpec: bindingsv1.KafkaAuthSpec{
+						BootstrapServers: []string{"kafka:9092"},
+					},
+					RetryAttemptExtension: &contextAttributeRetryAttemptExtension,
+					ConsumerGroup:         "ks-group",
+					SourceSpec: duckv1.SourceSpec{
+						Sink: NewSourceSinkReference(),
+					},
+				},
+			},
+			ctx:  context.Background(),
+			want: apis.ErrInvalidValue(contextAttributeRetryAttemptExtension, "spec.retryAttemptExtension"),
+		},
+		{
+			name: "retryAttemptExtension overriding a dead letter extension",
+			ks: &KafkaSource{
+				Spec: KafkaSourceSpec{
+					Topics: []string{"test-topic"},
+					KafkaAuthSpec: bindingsv1.KafkaAuthSpec{
+						BootstrapServers: []string{"kafka:9092"},
+					},
+					RetryAttemptExtension: &deadLetterRetryAttemptExtension,
+					ConsumerGroup:         "ks-group",
+					SourceSpec: duckv1.SourceSpec{
+						Sink: NewSourceSinkReference(),
+					},
+				},
+			},
+			ctx:  context.Background(),
+			want: apis.ErrInvalidValue(deadLetterRetryAttemptExtension, "spec.retryAttemptExtension"),
+		},
+		{
+			name: "valid retryAttemptExtension",
+			ks: &KafkaSource{
+				Spec: KafkaSourceSpec{
+					Topics: []string{"test-topic"},
+					KafkaAuthSpec: bindingsv1.KafkaAuthSpec{
+						BootstrapServers: []string{"kafka:9092"},
+					},
+					RetryAttemptExtension: &validRetryAttemptExtension,
+					ConsumerGroup:         "ks-group",
+					SourceSpec: duckv1.SourceSpec{
+						Sink: NewSourceSinkReference(),
+					},
+				},
+			},
+			ctx:  context.Background(),
+			want: nil,
+		},
+		{
+			name: "invalid auditSink",
+			ks: &KafkaSource{
+				Spec: KafkaSourceSpec{
+					Topics: []string{"test-topic"},
+					KafkaAuthSpec: bindingsv1.KafkaAuthSpec{
+						BootstrapServers: []string{"kafka:9092"},
+					},
+					AuditSink:     &duckv1.Destination{},
+					ConsumerGroup: "ks-group",
+					SourceSpec: duckv1.SourceSpec{
+						Sink: NewSourceSinkReference(),
+					},
+				},
+			},
+			ctx:  context.Background(),
+			want: apis.ErrGeneric("expected at least one, got none", "spec.auditSink.ref", "spec.auditSink.uri"),
+		},
+		{
+			name: "valid auditSink",
+			ks: &KafkaSource{
+				Spec: KafkaSourceSpec{
+					Topics: []string{"test-topic"},
+					KafkaAuthSpec: bindingsv1.KafkaAuthSpec{
+						BootstrapServers: []string{"kafka:9092"},
+					},
+					AuditSink:     &duckv1.Destination{URI: apis.HTTP("audit.example.com")},
+					ConsumerGroup: "ks-group",
+					SourceSpec: duckv1.SourceSpec{
+						Sink: NewSourceSinkReference(),
+					},
+				},
+			},
+			ctx:  context.Background(),
+			want: nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -164,3 +314,35 @@ func NewSourceSinkReference() duckv1.Destination {
 		},
 	}
 }
+
+func TestIsValidRetryAttemptExtension(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{name: "knativeerrorattempt", want: true},
+		{name: "attempt2", want: true},
+		{name: "", want: false},
+		{name: "Attempt", want: false},
+		{name: "knative-error-attempt", want: false},
+		{name: "id", want: false},
+		{name: "source", want: false},
+		{name: "specversion", want: false},
+		{name: "type", want: false},
+		{name: "datacontenttype", want: false},
+		{name: "dataschema", want: false},
+		{name: "subject", want: false},
+		{name: "time", want: false},
+		{name: "data", want: false},
+		{name: "knativeerrordest", want: false},
+		{name: "knativeerrorcode", want: false},
+		{name: "knativeerrordata", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidRetryAttemptExtension(tt.name); got != tt.want {
+				t.Errorf("IsValidRetryAttemptExtension(%q) = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+/*
+ * Copyright 2024 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+const (
+	// RebalanceProtocolEager revokes every partition of every consumer in the
+	// group before reassigning them, which stops consumption for the whole
+	// group for the duration of the rebalance.
+	RebalanceProtocolEager RebalanceProtocol = "eager"
+	// RebalanceProtocolCooperative only revokes the partitions that move to a
+	// different consumer, the other consumers keep processing their
+	// partitions while the group rebalances.
+	RebalanceProtocolCooperative RebalanceProtocol = "cooperative"
+)
@@ -24,6 +24,7 @@ package v1
 import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	duckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	apisduckv1 "knative.dev/pkg/apis/duck/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -111,6 +112,21 @@ func (in *KafkaSourceSpec) DeepCopyInto(out *KafkaSourceSpec) {
 		*out = new(DeliveryOrdering)
 		**out = **in
 	}
+	if in.RebalanceProtocol != nil {
+		in, out := &in.RebalanceProtocol, &out.RebalanceProtocol
+		*out = new(RebalanceProtocol)
+		**out = **in
+	}
+	if in.RetryAttemptExtension != nil {
+		in, out := &in.RetryAttemptExtension, &out.RetryAttemptExtension
+		*out = new(string)
+		**out = **in
+	}
+	if in.AuditSink != nil {
+		in, out := &in.AuditSink, &out.AuditSink
+		*out = new(apisduckv1.Destination)
+		(*in).DeepCopyInto(*out)
+	}
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
 	return
 }
@@ -129,6 +145,13 @@ func (in *KafkaSourceSpec) DeepCopy() *KafkaSourceSpec {
 func (in *KafkaSourceStatus) DeepCopyInto(out *KafkaSourceStatus) {
 	*out = *in
 	in.SourceStatus.DeepCopyInto(&out.SourceStatus)
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]TopicStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.Placeable.DeepCopyInto(&out.Placeable)
 	return
 }
@@ -142,3 +165,40 @@ func (in *KafkaSourceStatus) DeepCopy() *KafkaSourceStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PartitionStatus) DeepCopyInto(out *PartitionStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PartitionStatus.
+func (in *PartitionStatus) DeepCopy() *PartitionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PartitionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopicStatus) DeepCopyInto(out *TopicStatus) {
+	*out = *in
+	if in.Partitions != nil {
+		in, out := &in.Partitions, &out.Partitions
+		*out = make([]PartitionStatus, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicStatus.
+func (in *TopicStatus) DeepCopy() *TopicStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TopicStatus)
+	in.DeepCopyInto(out)
+	return out
+}
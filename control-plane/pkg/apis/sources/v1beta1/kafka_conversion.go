@@ -32,20 +32,24 @@ func (source *KafkaSource) ConvertTo(ctx context.Context, to apis.Convertible) e
 	case *v1.KafkaSource:
 		source.ObjectMeta.DeepCopyInto(&sink.ObjectMeta)
 		sink.Spec = v1.KafkaSourceSpec{
-			Consumers:     source.Spec.Consumers,
-			KafkaAuthSpec: *source.Spec.KafkaAuthSpec.ConvertToV1(ctx),
-			Topics:        source.Spec.Topics,
-			ConsumerGroup: source.Spec.ConsumerGroup,
-			InitialOffset: v1.Offset(source.Spec.InitialOffset),
-			Delivery:      source.Spec.Delivery,
-			Ordering:      (*v1.DeliveryOrdering)(source.Spec.Ordering),
-			SourceSpec:    source.Spec.SourceSpec,
+			Consumers:             source.Spec.Consumers,
+			KafkaAuthSpec:         *source.Spec.KafkaAuthSpec.ConvertToV1(ctx),
+			Topics:                source.Spec.Topics,
+			ConsumerGroup:         source.Spec.ConsumerGroup,
+			InitialOffset:         v1.Offset(source.Spec.InitialOffset),
+			Delivery:              source.Spec.Delivery,
+			Ordering:              (*v1.DeliveryOrdering)(source.Spec.Ordering),
+			RebalanceProtocol:     (*v1.RebalanceProtocol)(source.Spec.RebalanceProtocol),
+			RetryAttemptExtension: source.Spec.RetryAttemptExtension,
+			AuditSink:             source.Spec.AuditSink,
+			SourceSpec:            source.Spec.SourceSpec,
 		}
 		sink.Status = v1.KafkaSourceStatus{
 			SourceStatus: *source.Status.SourceStatus.DeepCopy(),
 			Consumers:    source.Status.Consumers,
 			Selector:     source.Status.Selector,
 			Claims:       source.Status.Claims,
+			Topics:       convertTopicStatusToV1(source.Status.Topics),
 			Placeable:    source.Status.Placeable,
 		}
 		return nil
@@ -63,20 +67,24 @@ func (sink *KafkaSource) ConvertFrom(ctx context.Context, from apis.Convertible)
 		authSpec := bindingsv1beta1.KafkaAuthSpec{}
 		authSpec.ConvertFromV1(&source.Spec.KafkaAuthSpec)
 		sink.Spec = KafkaSourceSpec{
-			Consumers:     source.Spec.Consumers,
-			KafkaAuthSpec: authSpec,
-			Topics:        source.Spec.Topics,
-			ConsumerGroup: source.Spec.ConsumerGroup,
-			InitialOffset: Offset(source.Spec.InitialOffset),
-			Delivery:      source.Spec.Delivery,
-			Ordering:      (*DeliveryOrdering)(source.Spec.Ordering),
-			SourceSpec:    source.Spec.SourceSpec,
+			Consumers:             source.Spec.Consumers,
+			KafkaAuthSpec:         authSpec,
+			Topics:                source.Spec.Topics,
+			ConsumerGroup:         source.Spec.ConsumerGroup,
+			InitialOffset:         Offset(source.Spec.InitialOffset),
+			Delivery:              source.Spec.Delivery,
+			Ordering:              (*DeliveryOrdering)(source.Spec.Ordering),
+			RebalanceProtocol:     (*RebalanceProtocol)(source.Spec.RebalanceProtocol),
+			RetryAttemptExtension: source.Spec.RetryAttemptExtension,
+			AuditSink:             source.Spec.AuditSink,
+			SourceSpec:            source.Spec.SourceSpec,
 		}
 		sink.Status = KafkaSourceStatus{
 			SourceStatus: source.Status.SourceStatus,
 			Consumers:    source.Status.Consumers,
 			Selector:     source.Status.Selector,
 			Claims:       source.Status.Claims,
+			Topics:       convertTopicStatusFromV1(source.Status.Topics),
 			Placeable:    source.Status.Placeable,
 		}
 
@@ -85,3 +93,33 @@ func (sink *KafkaSource) ConvertFrom(ctx context.Context, from apis.Convertible)
 		return fmt.Errorf("unknown version, got: %T", source)
 	}
 }
+
+func convertTopicStatusToV1(topics []TopicStatus) []v1.TopicStatus {
+	if topics == nil {
+		return nil
+	}
+	converted := make([]v1.TopicStatus, 0, len(topics))
+	for _, t := range topics {
+		topic := v1.TopicStatus{Name: t.Name, Deleted: t.Deleted}
+		for _, p := range t.Partitions {
+			topic.Partitions = append(topic.Partitions, v1.PartitionStatus(p))
+		}
+		converted = append(converted, topic)
+	}
+	return converted
+}
+
+func convertTopicStatusFromV1(topics []v1.TopicStatus) []TopicStatus {
+	if topics == nil {
+		return nil
+	}
+	converted := make([]TopicStatus, 0, len(topics))
+	for _, t := range topics {
+		topic := TopicStatus{Name: t.Name, Deleted: t.Deleted}
+		for _, p := range t.Partitions {
+			topic.Partitions = append(topic.Partitions, PartitionStatus(p))
+		}
+		converted = append(converted, topic)
+	}
+	return converted
+}
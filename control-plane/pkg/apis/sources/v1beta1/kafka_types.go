@@ -90,6 +90,41 @@ type KafkaSourceSpec struct {
 	// +optional
 	Ordering *DeliveryOrdering `json:"ordering,omitempty"`
 
+	// RebalanceProtocol is the rebalance protocol of the consumer group.
+	// Should be eager or cooperative.
+	// By default, it is eager.
+	// With eager, every consumer of the group stops dispatching while
+	// partitions are reassigned. With cooperative, only the partitions moving
+	// to a different consumer are revoked.
+	// With eager, the eager assignors configured for the dispatcher are used,
+	// or the range assignor when none is configured, followed by the
+	// cooperative sticky assignor. With cooperative, the assignors that don't
+	// support incremental rebalancing are dropped and the cooperative sticky
+	// assignor is used.
+	// Changing the protocol restarts the consumers of the group. As the
+	// consumers are restarted at different times, the cooperative sticky
+	// assignor is the common assignor of the group while switching, following
+	// Kafka's rolling upgrade path.
+	// +optional
+	RebalanceProtocol *RebalanceProtocol `json:"rebalanceProtocol,omitempty"`
+
+	// RetryAttemptExtension is the name of the CloudEvent extension set to the
+	// delivery attempt number when sending events to the sink, for example
+	// knativeerrorattempt. The first delivery is attempt 1 and each retry
+	// increments it. It must be made of lowercase letters and digits, and
+	// it can't be a CloudEvents context attribute or one of the extensions set
+	// on the events sent to the dead letter sink.
+	// By default, no extension is set.
+	// +optional
+	RetryAttemptExtension *string `json:"retryAttemptExtension,omitempty"`
+
+	// AuditSink is a reference to an object that will resolve to a URI where
+	// a CloudEvent is sent on each rebalance of the consumer group, with the
+	// partitions assigned to the consumer before and after the rebalance.
+	// Audit events are sent independently of the events sent to the sink.
+	// +optional
+	AuditSink *duckv1.Destination `json:"auditSink,omitempty"`
+
 	// inherits duck/v1 SourceSpec, which currently provides:
 	// * Sink - a reference to an object that will resolve to a domain name or
 	//   a URI directly to use as the sink.
@@ -100,6 +135,7 @@ type KafkaSourceSpec struct {
 
 type DeliveryOrdering string
 type Offset string
+type RebalanceProtocol string
 
 const (
 	// KafkaEventType is the Kafka CloudEvent type.
@@ -144,11 +180,47 @@ type KafkaSourceStatus struct {
 	// +optional
 	Claims string `json:"claims,omitempty"`
 
+	// Topics are the topics consumed by this KafkaSource instance with the
+	// range of offsets available in each of their partitions.
+	// +optional
+	Topics []TopicStatus `json:"topics,omitempty"`
+
 	// Implement Placeable.
 	// +optional
 	v1alpha1.Placeable `json:",inline"`
 }
 
+// TopicStatus defines the observed state of a topic consumed by a KafkaSource.
+type TopicStatus struct {
+	// Name is the name of the topic.
+	Name string `json:"name"`
+
+	// Partitions are the partitions of the topic with their earliest and latest offsets.
+	// It is empty when the offsets can't be retrieved, for example when the
+	// topic ACLs don't allow describing the topic.
+	// +optional
+	Partitions []PartitionStatus `json:"partitions,omitempty"`
+
+	// Deleted is true when the topic no longer exists in the Kafka cluster.
+	// The offsets committed for the topic are garbage collected once the topic
+	// is removed from the spec, since Kafka doesn't allow deleting the offsets
+	// of a topic the consumer group is subscribed to.
+	// +optional
+	Deleted bool `json:"deleted,omitempty"`
+}
+
+// PartitionStatus defines the observed range of offsets available in a partition.
+type PartitionStatus struct {
+	// Partition is the partition number.
+	Partition int32 `json:"partition"`
+
+	// EarliestOffset is the offset of the oldest record retained in the partition.
+	EarliestOffset int64 `json:"earliestOffset"`
+
+	// LatestOffset is the offset of the next record produced to the partition.
+	LatestOffset int64 `json:"latestOffset"`
+}
+
 func (*KafkaSource) GetGroupVersionKind() schema.GroupVersionKind {
 	return SchemeGroupVersion.WithKind("KafkaSource")
 }
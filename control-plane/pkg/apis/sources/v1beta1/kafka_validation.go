@@ -21,6 +21,8 @@ import (
 
 	"knative.dev/pkg/apis"
 	"knative.dev/pkg/kmp"
+
+	v1 "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/sources/v1"
 )
 
 // Validate ensures KafkaSource is properly configured.
@@ -58,6 +60,19 @@ func (kss *KafkaSourceSpec) Validate(ctx context.Context) *apis.FieldError {
 			errs = errs.Also(apis.ErrInvalidValue(*kss.Ordering, "ordering"))
 		}
 	}
+	if kss.RebalanceProtocol != nil {
+		switch *kss.RebalanceProtocol {
+		case RebalanceProtocolEager, RebalanceProtocolCooperative:
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(*kss.RebalanceProtocol, "rebalanceProtocol"))
+		}
+	}
+	if kss.RetryAttemptExtension != nil && !v1.IsValidRetryAttemptExtension(*kss.RetryAttemptExtension) {
+		errs = errs.Also(apis.ErrInvalidValue(*kss.RetryAttemptExtension, "retryAttemptExtension"))
+	}
+	if kss.AuditSink != nil {
+		errs = errs.Also(kss.AuditSink.Validate(ctx).ViaField("auditSink"))
+	}
 
 	return errs
 }
@@ -32,6 +32,12 @@ func TestKafka_Validate(t *testing.T) {
 	validOrdering := Ordered
 	badOrdering := DeliveryOrdering("badOrder")
 	badInitialOffset := Offset("badbOffset")
+	validRebalanceProtocol := RebalanceProtocolCooperative
+	badRebalanceProtocol := RebalanceProtocol("badProtocol")
+	validRetryAttemptExtension := "knativeerrorattempt"
+	badRetryAttemptExtension := "knative-error-attempt"
+	contextAttributeRetryAttemptExtension := "type"
+	deadLetterRetryAttemptExtension := "knativeerrorcode"
 
 	tests := []struct {
 		name string
@@ -124,6 +130,150 @@ func TestKafka_Validate(t *testing.T) {
 			ctx:  context.Background(),
 			want: apis.ErrInvalidValue(badInitialOffset, "spec.initialOffset"),
 		},
+		{
+			name: "invalid rebalanceProtocol",
+			ks: &KafkaSource{
+				Spec: KafkaSourceSpec{
+					Topics: []string{"test-topic"},
+					KafkaAuthSpec: bindingsv1beta1.KafkaAuthSpec{
+						BootstrapServers: []string{"kafka:9092"},
+					},
+					RebalanceProtocol: &badRebalanceProtocol,
+					ConsumerGroup:     "ks-group",
+					SourceSpec: duckv1.SourceSpec{
+						Sink: NewSourceSinkReference(),
+					},
+				},
+			},
+			ctx:  context.Background(),
+			want: apis.ErrInvalidValue(badRebalanceProtocol, "spec.rebalanceProtocol"),
+		},
+		{
+			name: "valid rebalanceProtocol",
+			ks: &KafkaSource{
+				Spec: KafkaSourceSpec{
+					Topics: []string{"test-topic"},
+					KafkaAuthSpec: bindingsv1beta1.KafkaAuthSpec{
+						BootstrapServers: []string{"kafka:9092"},
+					},
+					RebalanceProtocol: &validRebalanceProtocol,
+					ConsumerGroup:     "ks-group",
+					SourceSpec: duckv1.SourceSpec{
+						Sink: NewSourceSinkReference(),
+					},
+				},
+			},
+			ctx:  context.Background(),
+			want: nil,
+		},
+		{
+			name: "invalid retryAttemptExtension",
+			ks: &KafkaSource{
+				Spec: KafkaSourceSpec{
+					Topics: []string{"test-topic"},
+					KafkaAuthSpec: bindingsv1beta1.KafkaAuthSpec{
+						BootstrapServers: []string{"kafka:9092"},
+					},
+					RetryAttemptExtension: &badRetryAttemptExtension,
+					ConsumerGroup:         "ks-group",
+					SourceSpec: duckv1.SourceSpec{
+						Sink: NewSourceSinkReference(),
+					},
+				},
+			},
+			ctx:  context.Background(),
+			want: apis.ErrInvalidValue(badRetryAttemptExtension, "spec.retryAttemptExtension"),
+		},
+		{
+			name: "retryAttemptExtension overriding a context attribute",
+			ks: &KafkaSource{
+				Spec: KafkaSourceSpec{
+					Topics: []string{"test-topic"},
+					KafkaAuthSpec: bindingsv1beta1.KafkaAuthSpec{
+						BootstrapServers: []string{"kafka:9092"},
+					},
+					RetryAttemptExtension: &contextAttributeRetryAttemptExtension,
+					ConsumerGroup:         "ks-group",
+					SourceSpec: duckv1.SourceSpec{
+						Sink: NewSourceSinkReference(),
+					},
+				},
+			},
+			ctx:  context.Background(),
+			want: apis.ErrInvalidValue(contextAttributeRetryAttemptExtension, "spec.retryAttemptExtension"),
+		},
+		{
+			name: "retryAttemptExtension overriding a dead letter extension",
+			ks: &KafkaSource{
+				Spec: KafkaSourceSpec{
+					Topics: []string{"test-topic"},
+					KafkaAuthSpec: bindingsv1beta1.KafkaAuthSpec{
+						BootstrapServers: []string{"kafka:9092"},
+					},
+					RetryAttemptExtension: &deadLetterRetryAttemptExtension,
+					ConsumerGroup:         "ks-group",
+					SourceSpec: duckv1.SourceSpec{
+						Sink: NewSourceSinkReference(),
+					},
+				},
+			},
+			ctx:  context.Background(),
+			want: apis.ErrInvalidValue(deadLetterRetryAttemptExtension, "spec.retryAttemptExtension"),
+		},
+		{
+			name: "valid retryAttemptExtension",
+			ks: &KafkaSource{
+				Spec: KafkaSourceSpec{
+					Topics: []string{"test-topic"},
+					KafkaAuthSpec: bindingsv1beta1.KafkaAuthSpec{
+						BootstrapServers: []string{"kafka:9092"},
+					},
+					RetryAttemptExtension: &validRetryAttemptExtension,
+					ConsumerGroup:         "ks-group",
+					SourceSpec: duckv1.SourceSpec{
+						Sink: NewSourceSinkReference(),
+					},
+				},
+			},
+			ctx:  context.Background(),
+			want: nil,
+		},
+		{
+			name: "invalid auditSink",
+			ks: &KafkaSource{
+				Spec: KafkaSourceSpec{
+					Topics: []string{"test-topic"},
+					KafkaAuthSpec: bindingsv1beta1.KafkaAuthSpec{
+						BootstrapServers: []string{"kafka:9092"},
+					},
+					AuditSink:     &duckv1.Destination{},
+					ConsumerGroup: "ks-group",
+					SourceSpec: duckv1.SourceSpec{
+						Sink: NewSourceSinkReference(),
+					},
+				},
+			},
+			ctx:  context.Background(),
+			want: apis.ErrGeneric("expected at least one, got none", "spec.auditSink.ref", "spec.auditSink.uri"),
+		},
+		{
+			name: "valid auditSink",
+			ks: &KafkaSource{
+				Spec: KafkaSourceSpec{
+					Topics: []string{"test-topic"},
+					KafkaAuthSpec: bindingsv1beta1.KafkaAuthSpec{
+						BootstrapServers: []string{"kafka:9092"},
+					},
+					AuditSink:     &duckv1.Destination{URI: apis.HTTP("audit.example.com")},
+					ConsumerGroup: "ks-group",
+					SourceSpec: duckv1.SourceSpec{
+						Sink: NewSourceSinkReference(),
+					},
+				},
+			},
+			ctx:  context.Background(),
+			want: nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
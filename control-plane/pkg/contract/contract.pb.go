@@ -168,6 +168,59 @@ func (KeyType) EnumDescriptor() ([]byte, []int) {
 	return file_contract_proto_rawDescGZIP(), []int{2}
 }
 
+// Consumer group rebalance protocol
+type RebalanceProtocol int32
+
+const (
+	// The configured assignors are used as they are.
+	RebalanceProtocol_UNSPECIFIED RebalanceProtocol = 0
+	// Stop-the-world rebalance, all partitions are revoked before being reassigned.
+	RebalanceProtocol_EAGER RebalanceProtocol = 1
+	// Incremental rebalance, only partitions moving to another consumer are revoked.
+	RebalanceProtocol_COOPERATIVE RebalanceProtocol = 2
+)
+
+// Enum value maps for RebalanceProtocol.
+var (
+	RebalanceProtocol_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "EAGER",
+		2: "COOPERATIVE",
+	}
+	RebalanceProtocol_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"EAGER":       1,
+		"COOPERATIVE": 2,
+	}
+)
+
+func (x RebalanceProtocol) Enum() *RebalanceProtocol {
+	p := new(RebalanceProtocol)
+	*p = x
+	return p
+}
+
+func (x RebalanceProtocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RebalanceProtocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_contract_proto_enumTypes[3].Descriptor()
+}
+
+func (RebalanceProtocol) Type() protoreflect.EnumType {
+	return &file_contract_proto_enumTypes[3]
+}
+
+func (x RebalanceProtocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RebalanceProtocol.Descriptor instead.
+func (RebalanceProtocol) EnumDescriptor() ([]byte, []int) {
+	return file_contract_proto_rawDescGZIP(), []int{3}
+}
+
 // CloudEvent content mode
 type ContentMode int32
 
@@ -199,11 +252,11 @@ func (x ContentMode) String() string {
 }
 
 func (ContentMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_contract_proto_enumTypes[3].Descriptor()
+	return file_contract_proto_enumTypes[4].Descriptor()
 }
 
 func (ContentMode) Type() protoreflect.EnumType {
-	return &file_contract_proto_enumTypes[3]
+	return &file_contract_proto_enumTypes[4]
 }
 
 func (x ContentMode) Number() protoreflect.EnumNumber {
@@ -212,7 +265,7 @@ func (x ContentMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ContentMode.Descriptor instead.
 func (ContentMode) EnumDescriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{3}
+	return file_contract_proto_rawDescGZIP(), []int{4}
 }
 
 type SecretField int32
@@ -257,11 +310,11 @@ func (x SecretField) String() string {
 }
 
 func (SecretField) Descriptor() protoreflect.EnumDescriptor {
-	return file_contract_proto_enumTypes[4].Descriptor()
+	return file_contract_proto_enumTypes[5].Descriptor()
 }
 
 func (SecretField) Type() protoreflect.EnumType {
-	return &file_contract_proto_enumTypes[4]
+	return &file_contract_proto_enumTypes[5]
 }
 
 func (x SecretField) Number() protoreflect.EnumNumber {
@@ -270,7 +323,7 @@ func (x SecretField) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SecretField.Descriptor instead.
 func (SecretField) EnumDescriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{4}
+	return file_contract_proto_rawDescGZIP(), []int{5}
 }
 
 type Protocol int32
@@ -309,11 +362,11 @@ func (x Protocol) String() string {
 }
 
 func (Protocol) Descriptor() protoreflect.EnumDescriptor {
-	return file_contract_proto_enumTypes[5].Descriptor()
+	return file_contract_proto_enumTypes[6].Descriptor()
 }
 
 func (Protocol) Type() protoreflect.EnumType {
-	return &file_contract_proto_enumTypes[5]
+	return &file_contract_proto_enumTypes[6]
 }
 
 func (x Protocol) Number() protoreflect.EnumNumber {
@@ -322,7 +375,7 @@ func (x Protocol) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Protocol.Descriptor instead.
 func (Protocol) EnumDescriptor() ([]byte, []int) {
-	return file_contract_proto_rawDescGZIP(), []int{5}
+	return file_contract_proto_rawDescGZIP(), []int{6}
 }
 
 // We don't use the google.protobuf.Empty type because
@@ -1052,6 +1105,11 @@ type EgressConfig struct {
 	DeadLetterAudience string `protobuf:"bytes,7,opt,name=deadLetterAudience,proto3" json:"deadLetterAudience,omitempty"`
 	// format is the format used to deliver the event. Can be one of "json" or "binary"
 	Format string `protobuf:"bytes,8,opt,name=format,proto3" json:"format,omitempty"`
+	// retryAttemptExtension is the name of the CloudEvent extension set to the
+	// delivery attempt number of the event, starting at 1 and incremented on each retry.
+	//
+	// Setting retryAttemptExtension to empty means don't set the extension.
+	RetryAttemptExtension string `protobuf:"bytes,9,opt,name=retryAttemptExtension,proto3" json:"retryAttemptExtension,omitempty"`
 	// retry is the minimum number of retries the sender should attempt when
 	// sending an event before moving it to the dead letter sink.
 	//
@@ -1125,6 +1183,13 @@ func (x *EgressConfig) GetFormat() string {
 	return ""
 }
 
+func (x *EgressConfig) GetRetryAttemptExtension() string {
+	if x != nil {
+		return x.RetryAttemptExtension
+	}
+	return ""
+}
+
 func (x *EgressConfig) GetRetry() uint32 {
 	if x != nil {
 		return x.Retry
@@ -1203,6 +1268,16 @@ type Egress struct {
 	FeatureFlags *EgressFeatureFlags `protobuf:"bytes,14,opt,name=featureFlags,proto3" json:"featureFlags,omitempty"`
 	// Name of the service account to use for OIDC authentication.
 	OidcServiceAccountName string `protobuf:"bytes,19,opt,name=oidcServiceAccountName,proto3" json:"oidcServiceAccountName,omitempty"`
+	// Rebalance protocol of the consumer group.
+	// Empty means the configured assignors are used as they are.
+	RebalanceProtocol RebalanceProtocol `protobuf:"varint,20,opt,name=rebalanceProtocol,proto3,enum=RebalanceProtocol" json:"rebalanceProtocol,omitempty"`
+	// auditSink is the sink where a CloudEvent is sent on each rebalance of the consumer group.
+	// Empty means don't send rebalance events.
+	AuditSink string `protobuf:"bytes,21,opt,name=auditSink,proto3" json:"auditSink,omitempty"`
+	// auditSink CA Cert is the CA Cert used for HTTPS communication through auditSink
+	AuditSinkCACerts string `protobuf:"bytes,22,opt,name=auditSinkCACerts,proto3" json:"auditSinkCACerts,omitempty"`
+	// OIDC audience of the auditSink
+	AuditSinkAudience string `protobuf:"bytes,23,opt,name=auditSinkAudience,proto3" json:"auditSinkAudience,omitempty"`
 }
 
 func (x *Egress) Reset() {
@@ -1377,6 +1452,34 @@ func (x *Egress) GetOidcServiceAccountName() string {
 	return ""
 }
 
+func (x *Egress) GetRebalanceProtocol() RebalanceProtocol {
+	if x != nil {
+		return x.RebalanceProtocol
+	}
+	return RebalanceProtocol_UNSPECIFIED
+}
+
+func (x *Egress) GetAuditSink() string {
+	if x != nil {
+		return x.AuditSink
+	}
+	return ""
+}
+
+func (x *Egress) GetAuditSinkCACerts() string {
+	if x != nil {
+		return x.AuditSinkCACerts
+	}
+	return ""
+}
+
+func (x *Egress) GetAuditSinkAudience() string {
+	if x != nil {
+		return x.AuditSinkAudience
+	}
+	return ""
+}
+
 type isEgress_ReplyStrategy interface {
 	isEgress_ReplyStrategy()
 }
@@ -2273,7 +2376,7 @@ var file_contract_proto_rawDesc = []byte{
 	0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
 	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63,
 	0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x73, 0x22, 0xae, 0x02, 0x0a, 0x0c, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e,
+	0x72, 0x73, 0x22, 0xe4, 0x02, 0x0a, 0x0c, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e,
 	0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
 	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
 	0x74, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
@@ -2283,68 +2386,83 @@ var file_contract_proto_rawDesc = []byte{
 	0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x64,
 	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63,
 	0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x74,
-	0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x12,
-	0x34, 0x0a, 0x0d, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66,
-	0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x61, 0x63,
-	0x6b, 0x6f, 0x66, 0x66, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d,
-	0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x22, 0xd8, 0x06, 0x0a, 0x06, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24,
-	0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x41, 0x43, 0x65, 0x72, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x41, 0x43, 0x65, 0x72, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x11, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x13, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c,
-	0x79, 0x55, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65,
-	0x70, 0x6c, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x3c, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54,
-	0x6f, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x14,
+	0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x72, 0x65, 0x74, 0x72, 0x79, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x0d, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x42,
+	0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x62, 0x61,
+	0x63, 0x6b, 0x6f, 0x66, 0x66, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x62,
+	0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x92, 0x08, 0x0a, 0x06, 0x45, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x12,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x41, 0x43, 0x65, 0x72,
+	0x74, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x41, 0x43, 0x65, 0x72, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x13,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c,
+	0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x3c, 0x0a, 0x14,
 	0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x54,
-	0x6f, 0x70, 0x69, 0x63, 0x12, 0x2c, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x72, 0x6c, 0x43, 0x41,
-	0x43, 0x65, 0x72, 0x74, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x70,
-	0x6c, 0x79, 0x55, 0x72, 0x6c, 0x43, 0x41, 0x43, 0x65, 0x72, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x10,
-	0x72, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x72, 0x6c, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65,
-	0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x72, 0x6c,
-	0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x0c, 0x65,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x0c, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x34,
-	0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x08, 0x2e, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0f, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x44, 0x69,
-	0x61, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0f, 0x64,
-	0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1c,
-	0x0a, 0x09, 0x76, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x09, 0x76, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x37, 0x0a, 0x0c,
-	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x46, 0x65, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x0c, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x36, 0x0a, 0x16, 0x6f, 0x69, 0x64, 0x63, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x6f, 0x69, 0x64, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0f, 0x0a,
+	0x6f, 0x70, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x48, 0x00, 0x52, 0x14, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x2c, 0x0a, 0x0c, 0x64, 0x69,
+	0x73, 0x63, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x06, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x6c,
+	0x79, 0x55, 0x72, 0x6c, 0x43, 0x41, 0x43, 0x65, 0x72, 0x74, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x72, 0x6c, 0x43, 0x41, 0x43, 0x65, 0x72,
+	0x74, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x72, 0x6c, 0x41, 0x75,
+	0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65,
+	0x70, 0x6c, 0x79, 0x55, 0x72, 0x6c, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1f,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07,
+	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69,
+	0x64, 0x12, 0x31, 0x0a, 0x0c, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0d, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x07, 0x6b, 0x65,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x08, 0x2e, 0x4b, 0x65,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28,
+	0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0f, 0x64, 0x69, 0x61, 0x6c,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x0f, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x76, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61,
+	0x67, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x0c, 0x66,
+	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x36, 0x0a, 0x16, 0x6f,
+	0x69, 0x64, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x6f, 0x69, 0x64,
+	0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x11, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x52, 0x11, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x64, 0x69, 0x74, 0x53, 0x69,
+	0x6e, 0x6b, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x64, 0x69, 0x74, 0x53,
+	0x69, 0x6e, 0x6b, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x75, 0x64, 0x69, 0x74, 0x53, 0x69, 0x6e, 0x6b,
+	0x43, 0x41, 0x43, 0x65, 0x72, 0x74, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61,
+	0x75, 0x64, 0x69, 0x74, 0x53, 0x69, 0x6e, 0x6b, 0x43, 0x41, 0x43, 0x65, 0x72, 0x74, 0x73, 0x12,
+	0x2c, 0x0a, 0x11, 0x61, 0x75, 0x64, 0x69, 0x74, 0x53, 0x69, 0x6e, 0x6b, 0x41, 0x75, 0x64, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x75, 0x64, 0x69,
+	0x74, 0x53, 0x69, 0x6e, 0x6b, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x0f, 0x0a,
 	0x0d, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x22, 0x86,
 	0x01, 0x0a, 0x12, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
 	0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52,
@@ -2463,26 +2581,30 @@ var file_contract_proto_rawDesc = []byte{
 	0x74, 0x72, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x6e, 0x74, 0x65, 0x67,
 	0x65, 0x72, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x10, 0x02,
 	0x12, 0x0d, 0x0a, 0x09, 0x42, 0x79, 0x74, 0x65, 0x41, 0x72, 0x72, 0x61, 0x79, 0x10, 0x03, 0x2a,
-	0x29, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0a,
-	0x0a, 0x06, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54,
-	0x52, 0x55, 0x43, 0x54, 0x55, 0x52, 0x45, 0x44, 0x10, 0x01, 0x2a, 0x61, 0x0a, 0x0b, 0x53, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x41, 0x53,
-	0x4c, 0x5f, 0x4d, 0x45, 0x43, 0x48, 0x41, 0x4e, 0x49, 0x53, 0x4d, 0x10, 0x00, 0x12, 0x0a, 0x0a,
-	0x06, 0x43, 0x41, 0x5f, 0x43, 0x52, 0x54, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x55, 0x53, 0x45,
-	0x52, 0x5f, 0x43, 0x52, 0x54, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x55, 0x53, 0x45, 0x52, 0x5f,
-	0x4b, 0x45, 0x59, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x04, 0x12,
-	0x0c, 0x0a, 0x08, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x05, 0x2a, 0x44, 0x0a,
-	0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x4c, 0x41,
-	0x49, 0x4e, 0x54, 0x45, 0x58, 0x54, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x41, 0x53, 0x4c,
-	0x5f, 0x50, 0x4c, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x58, 0x54, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03,
-	0x53, 0x53, 0x4c, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x41, 0x53, 0x4c, 0x5f, 0x53, 0x53,
-	0x4c, 0x10, 0x03, 0x42, 0x5b, 0x0a, 0x2a, 0x64, 0x65, 0x76, 0x2e, 0x6b, 0x6e, 0x61, 0x74, 0x69,
-	0x76, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x61, 0x66, 0x6b,
-	0x61, 0x2e, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x42, 0x11, 0x44, 0x61, 0x74, 0x61, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x5a, 0x1a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2d, 0x70, 0x6c,
-	0x61, 0x6e, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x40, 0x0a, 0x11, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x41, 0x47, 0x45, 0x52, 0x10, 0x01,
+	0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x4f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x56, 0x45, 0x10,
+	0x02, 0x2a, 0x29, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x0a, 0x0a, 0x06, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a,
+	0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x55, 0x52, 0x45, 0x44, 0x10, 0x01, 0x2a, 0x61, 0x0a, 0x0b,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x0e, 0x53,
+	0x41, 0x53, 0x4c, 0x5f, 0x4d, 0x45, 0x43, 0x48, 0x41, 0x4e, 0x49, 0x53, 0x4d, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x43, 0x41, 0x5f, 0x43, 0x52, 0x54, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x55,
+	0x53, 0x45, 0x52, 0x5f, 0x43, 0x52, 0x54, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x55, 0x53, 0x45,
+	0x52, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10,
+	0x04, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x05, 0x2a,
+	0x44, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x0d, 0x0a, 0x09, 0x50,
+	0x4c, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x58, 0x54, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x41,
+	0x53, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x58, 0x54, 0x10, 0x01, 0x12, 0x07,
+	0x0a, 0x03, 0x53, 0x53, 0x4c, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x41, 0x53, 0x4c, 0x5f,
+	0x53, 0x53, 0x4c, 0x10, 0x03, 0x42, 0x5b, 0x0a, 0x2a, 0x64, 0x65, 0x76, 0x2e, 0x6b, 0x6e, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x61,
+	0x66, 0x6b, 0x61, 0x2e, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x42, 0x11, 0x44, 0x61, 0x74, 0x61, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5a, 0x1a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2d,
+	0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2497,97 +2619,99 @@ func file_contract_proto_rawDescGZIP() []byte {
 	return file_contract_proto_rawDescData
 }
 
-var file_contract_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_contract_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
 var file_contract_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_contract_proto_goTypes = []interface{}{
 	(BackoffPolicy)(0),           // 0: BackoffPolicy
 	(DeliveryOrder)(0),           // 1: DeliveryOrder
 	(KeyType)(0),                 // 2: KeyType
-	(ContentMode)(0),             // 3: ContentMode
-	(SecretField)(0),             // 4: SecretField
-	(Protocol)(0),                // 5: Protocol
-	(*Empty)(nil),                // 6: Empty
-	(*Exact)(nil),                // 7: Exact
-	(*Prefix)(nil),               // 8: Prefix
-	(*Suffix)(nil),               // 9: Suffix
-	(*All)(nil),                  // 10: All
-	(*Any)(nil),                  // 11: Any
-	(*Not)(nil),                  // 12: Not
-	(*CESQL)(nil),                // 13: CESQL
-	(*DialectedFilter)(nil),      // 14: DialectedFilter
-	(*Filter)(nil),               // 15: Filter
-	(*TokenMatcher)(nil),         // 16: TokenMatcher
-	(*EventPolicy)(nil),          // 17: EventPolicy
-	(*EgressConfig)(nil),         // 18: EgressConfig
-	(*Egress)(nil),               // 19: Egress
-	(*EgressFeatureFlags)(nil),   // 20: EgressFeatureFlags
-	(*Ingress)(nil),              // 21: Ingress
-	(*Reference)(nil),            // 22: Reference
-	(*SecretReference)(nil),      // 23: SecretReference
-	(*KeyFieldReference)(nil),    // 24: KeyFieldReference
-	(*MultiSecretReference)(nil), // 25: MultiSecretReference
-	(*CloudEventOverrides)(nil),  // 26: CloudEventOverrides
-	(*FeatureFlags)(nil),         // 27: FeatureFlags
-	(*Resource)(nil),             // 28: Resource
-	(*Contract)(nil),             // 29: Contract
-	nil,                          // 30: Exact.AttributesEntry
-	nil,                          // 31: Prefix.AttributesEntry
-	nil,                          // 32: Suffix.AttributesEntry
-	nil,                          // 33: Filter.AttributesEntry
-	nil,                          // 34: CloudEventOverrides.ExtensionsEntry
+	(RebalanceProtocol)(0),       // 3: RebalanceProtocol
+	(ContentMode)(0),             // 4: ContentMode
+	(SecretField)(0),             // 5: SecretField
+	(Protocol)(0),                // 6: Protocol
+	(*Empty)(nil),                // 7: Empty
+	(*Exact)(nil),                // 8: Exact
+	(*Prefix)(nil),               // 9: Prefix
+	(*Suffix)(nil),               // 10: Suffix
+	(*All)(nil),                  // 11: All
+	(*Any)(nil),                  // 12: Any
+	(*Not)(nil),                  // 13: Not
+	(*CESQL)(nil),                // 14: CESQL
+	(*DialectedFilter)(nil),      // 15: DialectedFilter
+	(*Filter)(nil),               // 16: Filter
+	(*TokenMatcher)(nil),         // 17: TokenMatcher
+	(*EventPolicy)(nil),          // 18: EventPolicy
+	(*EgressConfig)(nil),         // 19: EgressConfig
+	(*Egress)(nil),               // 20: Egress
+	(*EgressFeatureFlags)(nil),   // 21: EgressFeatureFlags
+	(*Ingress)(nil),              // 22: Ingress
+	(*Reference)(nil),            // 23: Reference
+	(*SecretReference)(nil),      // 24: SecretReference
+	(*KeyFieldReference)(nil),    // 25: KeyFieldReference
+	(*MultiSecretReference)(nil), // 26: MultiSecretReference
+	(*CloudEventOverrides)(nil),  // 27: CloudEventOverrides
+	(*FeatureFlags)(nil),         // 28: FeatureFlags
+	(*Resource)(nil),             // 29: Resource
+	(*Contract)(nil),             // 30: Contract
+	nil,                          // 31: Exact.AttributesEntry
+	nil,                          // 32: Prefix.AttributesEntry
+	nil,                          // 33: Suffix.AttributesEntry
+	nil,                          // 34: Filter.AttributesEntry
+	nil,                          // 35: CloudEventOverrides.ExtensionsEntry
 }
 var file_contract_proto_depIdxs = []int32{
-	30, // 0: Exact.attributes:type_name -> Exact.AttributesEntry
-	31, // 1: Prefix.attributes:type_name -> Prefix.AttributesEntry
-	32, // 2: Suffix.attributes:type_name -> Suffix.AttributesEntry
-	14, // 3: All.filters:type_name -> DialectedFilter
-	14, // 4: Any.filters:type_name -> DialectedFilter
-	14, // 5: Not.filter:type_name -> DialectedFilter
-	7,  // 6: DialectedFilter.exact:type_name -> Exact
-	8,  // 7: DialectedFilter.prefix:type_name -> Prefix
-	9,  // 8: DialectedFilter.suffix:type_name -> Suffix
-	10, // 9: DialectedFilter.all:type_name -> All
-	11, // 10: DialectedFilter.any:type_name -> Any
-	12, // 11: DialectedFilter.not:type_name -> Not
-	13, // 12: DialectedFilter.cesql:type_name -> CESQL
-	33, // 13: Filter.attributes:type_name -> Filter.AttributesEntry
-	7,  // 14: TokenMatcher.exact:type_name -> Exact
-	8,  // 15: TokenMatcher.prefix:type_name -> Prefix
-	16, // 16: EventPolicy.tokenMatchers:type_name -> TokenMatcher
-	14, // 17: EventPolicy.filters:type_name -> DialectedFilter
+	31, // 0: Exact.attributes:type_name -> Exact.AttributesEntry
+	32, // 1: Prefix.attributes:type_name -> Prefix.AttributesEntry
+	33, // 2: Suffix.attributes:type_name -> Suffix.AttributesEntry
+	15, // 3: All.filters:type_name -> DialectedFilter
+	15, // 4: Any.filters:type_name -> DialectedFilter
+	15, // 5: Not.filter:type_name -> DialectedFilter
+	8,  // 6: DialectedFilter.exact:type_name -> Exact
+	9,  // 7: DialectedFilter.prefix:type_name -> Prefix
+	10, // 8: DialectedFilter.suffix:type_name -> Suffix
+	11, // 9: DialectedFilter.all:type_name -> All
+	12, // 10: DialectedFilter.any:type_name -> Any
+	13, // 11: DialectedFilter.not:type_name -> Not
+	14, // 12: DialectedFilter.cesql:type_name -> CESQL
+	34, // 13: Filter.attributes:type_name -> Filter.AttributesEntry
+	8,  // 14: TokenMatcher.exact:type_name -> Exact
+	9,  // 15: TokenMatcher.prefix:type_name -> Prefix
+	17, // 16: EventPolicy.tokenMatchers:type_name -> TokenMatcher
+	15, // 17: EventPolicy.filters:type_name -> DialectedFilter
 	0,  // 18: EgressConfig.backoffPolicy:type_name -> BackoffPolicy
-	6,  // 19: Egress.replyToOriginalTopic:type_name -> Empty
-	6,  // 20: Egress.discardReply:type_name -> Empty
-	15, // 21: Egress.filter:type_name -> Filter
-	18, // 22: Egress.egressConfig:type_name -> EgressConfig
+	7,  // 19: Egress.replyToOriginalTopic:type_name -> Empty
+	7,  // 20: Egress.discardReply:type_name -> Empty
+	16, // 21: Egress.filter:type_name -> Filter
+	19, // 22: Egress.egressConfig:type_name -> EgressConfig
 	1,  // 23: Egress.deliveryOrder:type_name -> DeliveryOrder
 	2,  // 24: Egress.keyType:type_name -> KeyType
-	22, // 25: Egress.reference:type_name -> Reference
-	14, // 26: Egress.dialectedFilter:type_name -> DialectedFilter
-	20, // 27: Egress.featureFlags:type_name -> EgressFeatureFlags
-	3,  // 28: Ingress.contentMode:type_name -> ContentMode
-	17, // 29: Ingress.eventPolicies:type_name -> EventPolicy
-	22, // 30: SecretReference.reference:type_name -> Reference
-	24, // 31: SecretReference.keyFieldReferences:type_name -> KeyFieldReference
-	4,  // 32: KeyFieldReference.field:type_name -> SecretField
-	5,  // 33: MultiSecretReference.protocol:type_name -> Protocol
-	23, // 34: MultiSecretReference.references:type_name -> SecretReference
-	34, // 35: CloudEventOverrides.extensions:type_name -> CloudEventOverrides.ExtensionsEntry
-	21, // 36: Resource.ingress:type_name -> Ingress
-	18, // 37: Resource.egressConfig:type_name -> EgressConfig
-	19, // 38: Resource.egresses:type_name -> Egress
-	6,  // 39: Resource.absentAuth:type_name -> Empty
-	22, // 40: Resource.authSecret:type_name -> Reference
-	25, // 41: Resource.multiAuthSecret:type_name -> MultiSecretReference
-	26, // 42: Resource.cloudEventOverrides:type_name -> CloudEventOverrides
-	22, // 43: Resource.reference:type_name -> Reference
-	27, // 44: Resource.featureFlags:type_name -> FeatureFlags
-	28, // 45: Contract.resources:type_name -> Resource
-	46, // [46:46] is the sub-list for method output_type
-	46, // [46:46] is the sub-list for method input_type
-	46, // [46:46] is the sub-list for extension type_name
-	46, // [46:46] is the sub-list for extension extendee
-	0,  // [0:46] is the sub-list for field type_name
+	23, // 25: Egress.reference:type_name -> Reference
+	15, // 26: Egress.dialectedFilter:type_name -> DialectedFilter
+	21, // 27: Egress.featureFlags:type_name -> EgressFeatureFlags
+	3,  // 28: Egress.rebalanceProtocol:type_name -> RebalanceProtocol
+	4,  // 29: Ingress.contentMode:type_name -> ContentMode
+	18, // 30: Ingress.eventPolicies:type_name -> EventPolicy
+	23, // 31: SecretReference.reference:type_name -> Reference
+	25, // 32: SecretReference.keyFieldReferences:type_name -> KeyFieldReference
+	5,  // 33: KeyFieldReference.field:type_name -> SecretField
+	6,  // 34: MultiSecretReference.protocol:type_name -> Protocol
+	24, // 35: MultiSecretReference.references:type_name -> SecretReference
+	35, // 36: CloudEventOverrides.extensions:type_name -> CloudEventOverrides.ExtensionsEntry
+	22, // 37: Resource.ingress:type_name -> Ingress
+	19, // 38: Resource.egressConfig:type_name -> EgressConfig
+	20, // 39: Resource.egresses:type_name -> Egress
+	7,  // 40: Resource.absentAuth:type_name -> Empty
+	23, // 41: Resource.authSecret:type_name -> Reference
+	26, // 42: Resource.multiAuthSecret:type_name -> MultiSecretReference
+	27, // 43: Resource.cloudEventOverrides:type_name -> CloudEventOverrides
+	23, // 44: Resource.reference:type_name -> Reference
+	28, // 45: Resource.featureFlags:type_name -> FeatureFlags
+	29, // 46: Contract.resources:type_name -> Resource
+	47, // [47:47] is the sub-list for method output_type
+	47, // [47:47] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_contract_proto_init() }
@@ -2913,7 +3037,7 @@ func file_contract_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_contract_proto_rawDesc,
-			NumEnums:      6,
+			NumEnums:      7,
 			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   0,
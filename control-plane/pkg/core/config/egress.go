@@ -94,6 +94,18 @@ func KeyTypeFromString(s string) contract.KeyType {
 	}
 }
 
+// RebalanceProtocolFromString returns the contract.RebalanceProtocol associated to a given string.
+func RebalanceProtocolFromString(s string) contract.RebalanceProtocol {
+	switch s {
+	case "cooperative":
+		return contract.RebalanceProtocol_COOPERATIVE
+	case "eager":
+		return contract.RebalanceProtocol_EAGER
+	default:
+		return contract.RebalanceProtocol_UNSPECIFIED
+	}
+}
+
 // SetDeadLetterSinkURIFromEgressConfig sets eventingduck.DeliveryStatus.DeadLetterSinkURI from a provided contract.EgressConfig.
 func SetDeadLetterSinkURIFromEgressConfig(dStatus *eventingduck.DeliveryStatus, egressConfig *contract.EgressConfig) {
 	if egressConfig == nil {
@@ -351,6 +351,33 @@ func TestKeyTypeFromString(t *testing.T) {
 	}
 }
 
+func TestRebalanceProtocolFromString(t *testing.T) {
+	tests := []struct {
+		s    string
+		want contract.RebalanceProtocol
+	}{
+		{
+			s:    "eager",
+			want: contract.RebalanceProtocol_EAGER,
+		},
+		{
+			s:    "cooperative",
+			want: contract.RebalanceProtocol_COOPERATIVE,
+		},
+		{
+			s:    "unknown",
+			want: contract.RebalanceProtocol_UNSPECIFIED,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := RebalanceProtocolFromString(tt.s); got != tt.want {
+				t.Errorf("RebalanceProtocolFromString() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestSetDeadLetterSinkURIFromEgressConfig(t *testing.T) {
 	t.Parallel()
 
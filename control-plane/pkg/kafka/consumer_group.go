@@ -28,8 +28,21 @@ import (
 // InitOffsetsFunc initialize offsets for a provided set of topics and a provided consumer group id.
 type InitOffsetsFunc func(ctx context.Context, kafkaClient sarama.Client, kafkaAdminClient sarama.ClusterAdmin, topics []string, consumerGroup string) (int32, error)
 
+// GetTopicsOffsetsFunc returns the earliest and the latest offsets of every partition of the provided topics.
+type GetTopicsOffsetsFunc func(ctx context.Context, kafkaClient sarama.Client, topics []string) (map[string]map[int32]offset.PartitionOffsets, error)
+
+// GetDeletedTopicsFunc returns the topics that don't exist in the Kafka cluster among the provided topics and the
+// topics for which the provided consumer group has committed offsets.
+type GetDeletedTopicsFunc func(ctx context.Context, kafkaAdminClient sarama.ClusterAdmin, topics []string, consumerGroup string) ([]string, error)
+
+// DeleteTopicsOffsetsFunc deletes the offsets committed by the provided consumer group for the provided topics.
+type DeleteTopicsOffsetsFunc func(ctx context.Context, kafkaAdminClient sarama.ClusterAdmin, topics []string, consumerGroup string) error
+
 var (
-	_ InitOffsetsFunc = offset.InitOffsets
+	_ InitOffsetsFunc         = offset.InitOffsets
+	_ GetTopicsOffsetsFunc    = offset.GetTopicsOffsets
+	_ GetDeletedTopicsFunc    = offset.GetDeletedTopics
+	_ DeleteTopicsOffsetsFunc = offset.DeleteTopicsOffsets
 )
 
 const (
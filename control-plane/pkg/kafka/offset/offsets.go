@@ -18,6 +18,7 @@ package offset
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/IBM/sarama"
@@ -118,6 +119,163 @@ func CheckIfAllOffsetsInitialized(kafkaClient sarama.Client, kafkaAdminClient sa
 	return true, nil
 }
 
+// PartitionOffsets is the range of offsets available in a partition.
+type PartitionOffsets struct {
+	// Earliest is the offset of the oldest record retained in the partition.
+	Earliest int64
+	// Latest is the offset of the next record produced to the partition.
+	Latest int64
+}
+
+// GetTopicsOffsets returns the earliest and the latest offsets of every partition of the provided topics.
+// Topics that we are not authorized to describe, or that don't exist, are returned without partitions, so that
+// a single topic restricted by ACLs or deleted doesn't prevent reporting the offsets of the other topics.
+func GetTopicsOffsets(ctx context.Context, kafkaClient sarama.Client, topics []string) (map[string]map[int32]PartitionOffsets, error) {
+	offsets := make(map[string]map[int32]PartitionOffsets, len(topics))
+	for _, topic := range topics {
+		partitionOffsets, err := getTopicOffsets(kafkaClient, topic)
+		if isAuthorizationError(err) {
+			logging.FromContext(ctx).Debugw("not authorized to get topic offsets", zap.String("topic", topic), zap.Error(err))
+			offsets[topic] = nil
+			continue
+		}
+		if errors.Is(err, sarama.ErrUnknownTopicOrPartition) {
+			logging.FromContext(ctx).Debugw("unknown topic", zap.String("topic", topic), zap.Error(err))
+			offsets[topic] = nil
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		offsets[topic] = partitionOffsets
+	}
+	return offsets, nil
+}
+
+func getTopicOffsets(kafkaClient sarama.Client, topic string) (map[int32]PartitionOffsets, error) {
+	_, topicPartitions, err := retrieveAllPartitions([]string{topic}, kafkaClient)
+	if err != nil {
+		return nil, err
+	}
+
+	earliest, err := knsarama.GetOffsets(kafkaClient, topicPartitions, sarama.OffsetOldest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the earliest offsets for topic %s: %w", topic, err)
+	}
+	latest, err := knsarama.GetOffsets(kafkaClient, topicPartitions, sarama.OffsetNewest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the latest offsets for topic %s: %w", topic, err)
+	}
+
+	partitionOffsets := make(map[int32]PartitionOffsets, len(topicPartitions[topic]))
+	for _, partition := range topicPartitions[topic] {
+		partitionOffsets[partition] = PartitionOffsets{
+			Earliest: earliest[topic][partition],
+			Latest:   latest[topic][partition],
+		}
+	}
+	return partitionOffsets, nil
+}
+
+func isAuthorizationError(err error) bool {
+	return errors.Is(err, sarama.ErrTopicAuthorizationFailed) ||
+		errors.Is(err, sarama.ErrClusterAuthorizationFailed)
+}
+
+// GetDeletedTopics returns the topics that don't exist in the Kafka cluster among the provided topics and the
+// topics for which the provided consumer group has committed offsets.
+//
+// Only topics explicitly reported as unknown by the cluster are returned, topics that we are not authorized to
+// describe are not considered deleted. An error is returned when the cluster metadata doesn't describe every
+// topic, since a partial metadata response doesn't prove that a topic has been deleted.
+func GetDeletedTopics(ctx context.Context, kafkaAdminClient sarama.ClusterAdmin, topics []string, consumerGroup string) ([]string, error) {
+	offsets, err := kafkaAdminClient.ListConsumerGroupOffsets(consumerGroup, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list offsets of consumer group %s: %w", consumerGroup, err)
+	}
+
+	candidates := make([]string, 0, len(topics)+len(offsets.Blocks))
+	candidates = append(candidates, topics...)
+	for topic := range offsets.Blocks {
+		if !contains(topics, topic) {
+			candidates = append(candidates, topic)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	metadata, err := kafkaAdminClient.DescribeTopics(candidates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe topics %v: %w", candidates, err)
+	}
+	described := make(map[string]*sarama.TopicMetadata, len(metadata))
+	for _, m := range metadata {
+		described[m.Name] = m
+	}
+
+	var deleted []string
+	for _, topic := range candidates {
+		m, ok := described[topic]
+		if !ok {
+			return nil, fmt.Errorf("incomplete cluster metadata, topic %s not described", topic)
+		}
+		if errors.Is(m.Err, sarama.ErrUnknownTopicOrPartition) {
+			logging.FromContext(ctx).Debugw("topic reported as unknown", zap.String("topic", topic))
+			deleted = append(deleted, topic)
+		}
+	}
+	return deleted, nil
+}
+
+// DeleteTopicsOffsets deletes the offsets committed by the provided consumer group for the provided topics.
+//
+// Kafka doesn't allow deleting the offsets of a topic the consumer group is actively subscribed to, such topics
+// are skipped and reported with an error wrapping sarama.ErrGroupSubscribedToTopic once the offsets of every
+// other topic have been deleted.
+func DeleteTopicsOffsets(ctx context.Context, kafkaAdminClient sarama.ClusterAdmin, topics []string, consumerGroup string) error {
+	offsets, err := kafkaAdminClient.ListConsumerGroupOffsets(consumerGroup, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list offsets of consumer group %s: %w", consumerGroup, err)
+	}
+
+	var subscribed []string
+	for _, topic := range topics {
+		for partition, block := range offsets.Blocks[topic] {
+			if block.Offset == -1 { // not committed
+				continue
+			}
+			err := kafkaAdminClient.DeleteConsumerGroupOffset(consumerGroup, topic, partition)
+			if errors.Is(err, sarama.ErrGroupSubscribedToTopic) {
+				subscribed = append(subscribed, topic)
+				break
+			}
+			// The broker might have already removed offsets of the deleted partition.
+			if err != nil && !errors.Is(err, sarama.ErrUnknownTopicOrPartition) {
+				return fmt.Errorf("failed to delete offset of consumer group %s for topic %s partition %d: %w", consumerGroup, topic, partition, err)
+			}
+			logging.FromContext(ctx).Debugw("offset deleted",
+				zap.String("consumerGroup", consumerGroup),
+				zap.String("topic", topic),
+				zap.Int32("partition", partition),
+			)
+		}
+	}
+	if len(subscribed) > 0 {
+		return fmt.Errorf("consumer group %s is subscribed to topics %v: %w", consumerGroup, subscribed, sarama.ErrGroupSubscribedToTopic)
+	}
+	return nil
+}
+
+func contains(topics []string, topic string) bool {
+	for _, t := range topics {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
+
 func retrieveAllPartitions(topics []string, kafkaClient sarama.Client) (int, map[string][]int32, error) {
 	totalPartitions := 0
 
@@ -0,0 +1,378 @@
+/*
+ * Copyright 2024 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package offset
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/google/go-cmp/cmp"
+
+	kafkatesting "knative.dev/eventing-kafka-broker/control-plane/pkg/kafka/testing"
+)
+
+func TestGetDeletedTopics(t *testing.T) {
+	tests := []struct {
+		name         string
+		clusterAdmin *kafkatesting.MockKafkaClusterAdmin
+		topics       []string
+		want         []string
+		wantErr      bool
+	}{
+		{
+			name: "no deleted topics",
+			clusterAdmin: &kafkatesting.MockKafkaClusterAdmin{
+				ExpectedTopics: []string{"t1", "t2"},
+				ExpectedTopicsMetadataOnDescribeTopics: []*sarama.TopicMetadata{
+					{Name: "t1"},
+					{Name: "t2"},
+				},
+			},
+			topics: []string{"t1", "t2"},
+		},
+		{
+			name: "deleted topic",
+			clusterAdmin: &kafkatesting.MockKafkaClusterAdmin{
+				ExpectedTopics: []string{"t1", "t2"},
+				ExpectedTopicsMetadataOnDescribeTopics: []*sarama.TopicMetadata{
+					{Name: "t1"},
+					{Name: "t2", Err: sarama.ErrUnknownTopicOrPartition},
+				},
+			},
+			topics: []string{"t1", "t2"},
+			want:   []string{"t2"},
+		},
+		{
+			name: "deleted topic with committed offsets only",
+			clusterAdmin: &kafkatesting.MockKafkaClusterAdmin{
+				ExpectedOffsetsOnListConsumerGroupOffsets: &sarama.OffsetFetchResponse{
+					Blocks: map[string]map[int32]*sarama.OffsetFetchResponseBlock{
+						"t1": {0: {Offset: 10}},
+						"t3": {0: {Offset: 5}},
+					},
+				},
+				ExpectedTopics: []string{"t1", "t3"},
+				ExpectedTopicsMetadataOnDescribeTopics: []*sarama.TopicMetadata{
+					{Name: "t1"},
+					{Name: "t3", Err: sarama.ErrUnknownTopicOrPartition},
+				},
+			},
+			topics: []string{"t1"},
+			want:   []string{"t3"},
+		},
+		{
+			name: "not authorized topic is not deleted",
+			clusterAdmin: &kafkatesting.MockKafkaClusterAdmin{
+				ExpectedTopics: []string{"t1"},
+				ExpectedTopicsMetadataOnDescribeTopics: []*sarama.TopicMetadata{
+					{Name: "t1", Err: sarama.ErrTopicAuthorizationFailed},
+				},
+			},
+			topics: []string{"t1"},
+		},
+		{
+			name: "incomplete metadata",
+			clusterAdmin: &kafkatesting.MockKafkaClusterAdmin{
+				ExpectedTopics: []string{"t1", "t2"},
+				ExpectedTopicsMetadataOnDescribeTopics: []*sarama.TopicMetadata{
+					{Name: "t2", Err: sarama.ErrUnknownTopicOrPartition},
+				},
+			},
+			topics:  []string{"t1", "t2"},
+			wantErr: true,
+		},
+		{
+			name: "failed to describe topics",
+			clusterAdmin: &kafkatesting.MockKafkaClusterAdmin{
+				ExpectedTopics:                []string{"t1"},
+				ExpectedErrorOnDescribeTopics: sarama.ErrOutOfBrokers,
+			},
+			topics:  []string{"t1"},
+			wantErr: true,
+		},
+		{
+			name: "failed to list consumer group offsets",
+			clusterAdmin: &kafkatesting.MockKafkaClusterAdmin{
+				ErrorOnListConsumerGroupOffsets: sarama.ErrOutOfBrokers,
+			},
+			topics:  []string{"t1"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.clusterAdmin.T = t
+
+			got, err := GetDeletedTopics(context.Background(), tt.clusterAdmin, tt.topics, "group")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetDeletedTopics() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetDeletedTopics() (-want, +got) %s", diff)
+			}
+		})
+	}
+}
+
+func TestDeleteTopicsOffsets(t *testing.T) {
+	tests := []struct {
+		name         string
+		clusterAdmin *kafkatesting.MockKafkaClusterAdmin
+		topics       []string
+		want         map[string][]int32
+		wantErr      bool
+		wantErrIs    error
+	}{
+		{
+			name: "delete committed offsets",
+			clusterAdmin: &kafkatesting.MockKafkaClusterAdmin{
+				ExpectedOffsetsOnListConsumerGroupOffsets: &sarama.OffsetFetchResponse{
+					Blocks: map[string]map[int32]*sarama.OffsetFetchResponseBlock{
+						"t1": {0: {Offset: 10}},
+						"t2": {0: {Offset: 5}},
+					},
+				},
+			},
+			topics: []string{"t2"},
+			want:   map[string][]int32{"t2": {0}},
+		},
+		{
+			name: "skip uncommitted offsets",
+			clusterAdmin: &kafkatesting.MockKafkaClusterAdmin{
+				ExpectedOffsetsOnListConsumerGroupOffsets: &sarama.OffsetFetchResponse{
+					Blocks: map[string]map[int32]*sarama.OffsetFetchResponseBlock{
+						"t2": {0: {Offset: -1}},
+					},
+				},
+			},
+			topics: []string{"t2"},
+		},
+		{
+			name: "failed to delete offset",
+			clusterAdmin: &kafkatesting.MockKafkaClusterAdmin{
+				ExpectedOffsetsOnListConsumerGroupOffsets: &sarama.OffsetFetchResponse{
+					Blocks: map[string]map[int32]*sarama.OffsetFetchResponseBlock{
+						"t2": {0: {Offset: 5}},
+					},
+				},
+				ErrorOnDeleteConsumerGroupOffset: sarama.ErrGroupAuthorizationFailed,
+			},
+			topics:  []string{"t2"},
+			wantErr: true,
+		},
+		{
+			name: "group subscribed to topic",
+			clusterAdmin: &kafkatesting.MockKafkaClusterAdmin{
+				ExpectedOffsetsOnListConsumerGroupOffsets: &sarama.OffsetFetchResponse{
+					Blocks: map[string]map[int32]*sarama.OffsetFetchResponseBlock{
+						"t2": {0: {Offset: 5}, 1: {Offset: 7}},
+					},
+				},
+				ErrorOnDeleteConsumerGroupOffset: sarama.ErrGroupSubscribedToTopic,
+			},
+			topics:    []string{"t2"},
+			wantErr:   true,
+			wantErrIs: sarama.ErrGroupSubscribedToTopic,
+		},
+		{
+			name: "offset already removed",
+			clusterAdmin: &kafkatesting.MockKafkaClusterAdmin{
+				ExpectedOffsetsOnListConsumerGroupOffsets: &sarama.OffsetFetchResponse{
+					Blocks: map[string]map[int32]*sarama.OffsetFetchResponseBlock{
+						"t2": {0: {Offset: 5}},
+					},
+				},
+				ErrorOnDeleteConsumerGroupOffset: sarama.ErrUnknownTopicOrPartition,
+			},
+			topics: []string{"t2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.clusterAdmin.T = t
+
+			err := DeleteTopicsOffsets(context.Background(), tt.clusterAdmin, tt.topics, "group")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DeleteTopicsOffsets() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("DeleteTopicsOffsets() error = %v, want %v", err, tt.wantErrIs)
+			}
+			if diff := cmp.Diff(tt.want, tt.clusterAdmin.DeletedConsumerGroupOffsets); diff != "" {
+				t.Errorf("DeleteTopicsOffsets() deleted offsets (-want, +got) %s", diff)
+			}
+		})
+	}
+}
+
+func TestGetTopicsOffsets(t *testing.T) {
+	tests := []struct {
+		name      string
+		handlers  func(t *testing.T, broker *sarama.MockBroker) map[string]sarama.MockResponse
+		topics    []string
+		want      map[string]map[int32]PartitionOffsets
+		wantErrIs error
+	}{
+		{
+			name: "topics offsets",
+			handlers: func(t *testing.T, broker *sarama.MockBroker) map[string]sarama.MockResponse {
+				return map[string]sarama.MockResponse{
+					"MetadataRequest": sarama.NewMockMetadataResponse(t).
+						SetBroker(broker.Addr(), broker.BrokerID()).
+						SetLeader("t1", 0, broker.BrokerID()).
+						SetLeader("t1", 1, broker.BrokerID()),
+					"OffsetRequest": sarama.NewMockOffsetResponse(t).
+						SetOffset("t1", 0, sarama.OffsetOldest, 2).
+						SetOffset("t1", 0, sarama.OffsetNewest, 10).
+						SetOffset("t1", 1, sarama.OffsetOldest, 0).
+						SetOffset("t1", 1, sarama.OffsetNewest, 5),
+				}
+			},
+			topics: []string{"t1"},
+			want: map[string]map[int32]PartitionOffsets{
+				"t1": {
+					0: {Earliest: 2, Latest: 10},
+					1: {Earliest: 0, Latest: 5},
+				},
+			},
+		},
+		{
+			name: "not authorized to describe topic",
+			handlers: func(t *testing.T, broker *sarama.MockBroker) map[string]sarama.MockResponse {
+				return map[string]sarama.MockResponse{
+					"MetadataRequest": sarama.NewMockMetadataResponse(t).
+						SetBroker(broker.Addr(), broker.BrokerID()).
+						SetLeader("t1", 0, broker.BrokerID()).
+						SetError("t2", sarama.ErrTopicAuthorizationFailed),
+					"OffsetRequest": sarama.NewMockOffsetResponse(t).
+						SetOffset("t1", 0, sarama.OffsetOldest, 2).
+						SetOffset("t1", 0, sarama.OffsetNewest, 10),
+				}
+			},
+			topics: []string{"t1", "t2"},
+			want: map[string]map[int32]PartitionOffsets{
+				"t1": {0: {Earliest: 2, Latest: 10}},
+				"t2": nil,
+			},
+		},
+		{
+			name: "unknown topic",
+			handlers: func(t *testing.T, broker *sarama.MockBroker) map[string]sarama.MockResponse {
+				return map[string]sarama.MockResponse{
+					"MetadataRequest": sarama.NewMockMetadataResponse(t).
+						SetBroker(broker.Addr(), broker.BrokerID()).
+						SetLeader("t1", 0, broker.BrokerID()),
+					"OffsetRequest": sarama.NewMockOffsetResponse(t).
+						SetOffset("t1", 0, sarama.OffsetOldest, 2).
+						SetOffset("t1", 0, sarama.OffsetNewest, 10),
+				}
+			},
+			topics: []string{"t1", "t2"},
+			want: map[string]map[int32]PartitionOffsets{
+				"t1": {0: {Earliest: 2, Latest: 10}},
+				"t2": nil,
+			},
+		},
+		{
+			name: "not authorized to get topic offsets",
+			handlers: func(t *testing.T, broker *sarama.MockBroker) map[string]sarama.MockResponse {
+				return map[string]sarama.MockResponse{
+					"MetadataRequest": sarama.NewMockMetadataResponse(t).
+						SetBroker(broker.Addr(), broker.BrokerID()).
+						SetLeader("t1", 0, broker.BrokerID()),
+					"OffsetRequest": offsetErrorResponse("t1", 0, sarama.ErrTopicAuthorizationFailed),
+				}
+			},
+			topics: []string{"t1"},
+			want: map[string]map[int32]PartitionOffsets{
+				"t1": nil,
+			},
+		},
+		{
+			name: "failed to get topic partitions",
+			handlers: func(t *testing.T, broker *sarama.MockBroker) map[string]sarama.MockResponse {
+				return map[string]sarama.MockResponse{
+					// The client fetches the metadata of every topic when it's created.
+					"MetadataRequest": sarama.NewMockSequence(
+						sarama.NewMockMetadataResponse(t).
+							SetBroker(broker.Addr(), broker.BrokerID()),
+						sarama.NewMockMetadataResponse(t).
+							SetBroker(broker.Addr(), broker.BrokerID()).
+							SetError("t1", sarama.ErrInvalidTopic),
+					),
+				}
+			},
+			topics:    []string{"t1"},
+			wantErrIs: sarama.ErrInvalidTopic,
+		},
+		{
+			name: "failed to get topic offsets",
+			handlers: func(t *testing.T, broker *sarama.MockBroker) map[string]sarama.MockResponse {
+				return map[string]sarama.MockResponse{
+					"MetadataRequest": sarama.NewMockMetadataResponse(t).
+						SetBroker(broker.Addr(), broker.BrokerID()).
+						SetLeader("t1", 0, broker.BrokerID()),
+					"OffsetRequest": offsetErrorResponse("t1", 0, sarama.ErrNotLeaderForPartition),
+				}
+			},
+			topics:    []string{"t1"},
+			wantErrIs: sarama.ErrNotLeaderForPartition,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			broker := sarama.NewMockBroker(t, 1)
+			defer broker.Close()
+			broker.SetHandlerByMap(tt.handlers(t, broker))
+
+			config := sarama.NewConfig()
+			config.Metadata.Retry.Max = 0
+			client, err := sarama.NewClient([]string{broker.Addr()}, config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer client.Close()
+
+			got, err := GetTopicsOffsets(context.Background(), client, tt.topics)
+			if tt.wantErrIs != nil {
+				if !errors.Is(err, tt.wantErrIs) {
+					t.Errorf("GetTopicsOffsets() error = %v, want %v", err, tt.wantErrIs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetTopicsOffsets() unexpected error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetTopicsOffsets() (-want, +got) %s", diff)
+			}
+		})
+	}
+}
+
+func offsetErrorResponse(topic string, partition int32, kerr sarama.KError) sarama.MockResponse {
+	return sarama.NewMockWrapper(&sarama.OffsetResponse{
+		Version: 1,
+		Blocks: map[string]map[int32]*sarama.OffsetResponseBlock{
+			topic: {partition: {Err: kerr}},
+		},
+	})
+}
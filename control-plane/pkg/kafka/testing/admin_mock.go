@@ -58,6 +58,14 @@ type MockKafkaClusterAdmin struct {
 
 	ErrorOnDeleteConsumerGroup error
 
+	// ListConsumerGroupOffsets
+	ExpectedOffsetsOnListConsumerGroupOffsets *sarama.OffsetFetchResponse
+	ErrorOnListConsumerGroupOffsets           error
+
+	// DeleteConsumerGroupOffset
+	DeletedConsumerGroupOffsets      map[string][]int32
+	ErrorOnDeleteConsumerGroupOffset error
+
 	OnClose func()
 
 	T *testing.T
@@ -74,7 +82,14 @@ func (m *MockKafkaClusterAdmin) DeleteConsumerGroupOffset(group string, topic st
 	if m.ErrorBrokenPipe {
 		return brokenPipeError{}
 	}
-	panic("implement me")
+	if m.ErrorOnDeleteConsumerGroupOffset != nil {
+		return m.ErrorOnDeleteConsumerGroupOffset
+	}
+	if m.DeletedConsumerGroupOffsets == nil {
+		m.DeletedConsumerGroupOffsets = make(map[string][]int32)
+	}
+	m.DeletedConsumerGroupOffsets[topic] = append(m.DeletedConsumerGroupOffsets[topic], partition)
+	return nil
 }
 
 func (m *MockKafkaClusterAdmin) DescribeClientQuotas(components []sarama.QuotaFilterComponent, strict bool) ([]sarama.DescribeClientQuotasEntry, error) {
@@ -270,7 +285,13 @@ func (m *MockKafkaClusterAdmin) ListConsumerGroupOffsets(group string, topicPart
 	if m.ErrorBrokenPipe {
 		return nil, brokenPipeError{}
 	}
-	panic("implement me")
+	if m.ErrorOnListConsumerGroupOffsets != nil {
+		return nil, m.ErrorOnListConsumerGroupOffsets
+	}
+	if m.ExpectedOffsetsOnListConsumerGroupOffsets == nil {
+		return &sarama.OffsetFetchResponse{}, nil
+	}
+	return m.ExpectedOffsetsOnListConsumerGroupOffsets, nil
 }
 
 func (m *MockKafkaClusterAdmin) DeleteConsumerGroup(group string) error {
@@ -174,6 +174,13 @@ func (r *Reconciler) reconcileContractEgress(ctx context.Context, c *kafkaintern
 		if err != nil {
 			return nil, err
 		}
+		if egressConfig == nil && c.Spec.Delivery.RetryAttemptExtension != "" {
+			// The delivery spec sets neither a dead letter sink, retries nor a timeout.
+			egressConfig = &contract.EgressConfig{}
+		}
+		if egressConfig != nil {
+			egressConfig.RetryAttemptExtension = c.Spec.Delivery.RetryAttemptExtension
+		}
 	}
 	if egressConfig != nil {
 		c.Status.DeliveryStatus.DeadLetterSinkURI, _ = apis.ParseURL(egressConfig.DeadLetter)
@@ -216,10 +223,32 @@ func (r *Reconciler) reconcileContractEgress(ctx context.Context, c *kafkaintern
 		egress.KeyType = coreconfig.KeyTypeFromString(*c.Spec.Configs.KeyType)
 	}
 
+	if c.Spec.Configs.RebalanceProtocol != nil {
+		egress.RebalanceProtocol = coreconfig.RebalanceProtocolFromString(*c.Spec.Configs.RebalanceProtocol)
+	}
+
 	if c.Spec.OIDCServiceAccountName != nil {
 		egress.OidcServiceAccountName = *c.Spec.OIDCServiceAccountName
 	}
 
+	if c.Spec.AuditSink != nil {
+		auditSink := c.Spec.AuditSink.DeepCopy() // Do not update object Spec, so copy destination.
+		if auditSink.Ref != nil && auditSink.Ref.Namespace == "" {
+			auditSink.Ref.Namespace = c.GetNamespace()
+		}
+		auditSinkAddr, err := r.Resolver.AddressableFromDestinationV1(ctx, *auditSink, c)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve audit sink: %w", err)
+		}
+		egress.AuditSink = auditSinkAddr.URL.String()
+		if auditSinkAddr.CACerts != nil {
+			egress.AuditSinkCACerts = *auditSinkAddr.CACerts
+		}
+		if auditSinkAddr.Audience != nil {
+			egress.AuditSinkAudience = *auditSinkAddr.Audience
+		}
+	}
+
 	if err := r.reconcileReplyStrategy(ctx, c, egress); err != nil {
 		return nil, fmt.Errorf("failed to reconcile reply strategy: %w", err)
 	}
@@ -35,6 +35,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/storage/names"
 	"k8s.io/client-go/kubernetes"
 	corelisters "k8s.io/client-go/listers/core/v1"
@@ -62,11 +63,17 @@ import (
 	kafkainternalslisters "knative.dev/eventing-kafka-broker/control-plane/pkg/client/listers/internalskafkaeventing/v1alpha1"
 	"knative.dev/eventing-kafka-broker/control-plane/pkg/counter"
 	"knative.dev/eventing-kafka-broker/control-plane/pkg/kafka"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/kafka/offset"
 	"knative.dev/eventing-kafka-broker/control-plane/pkg/reconciler/base"
 	kedav1alpha1 "knative.dev/eventing-kafka-broker/third_party/pkg/apis/keda/v1alpha1"
 	kedaclientset "knative.dev/eventing-kafka-broker/third_party/pkg/client/clientset/versioned"
 )
 
+var (
+	// topicsStatusTimeout bounds the time spent retrieving the topics offsets reported in the status.
+	topicsStatusTimeout = 5 * time.Second
+)
+
 var (
 	ErrNoSubscriberURI     = errors.New("no subscriber URI resolved")
 	ErrNoDeadLetterSinkURI = errors.New("no dead letter sink URI resolved")
@@ -181,6 +188,41 @@ type Reconciler struct {
 	// This leads to increased "time to readiness" for consumer groups.
 	InitOffsetLatestInitialOffsetCache prober.Cache[string, prober.Status, struct{}]
 
+	// GetTopicsOffsetsFunc retrieves the earliest and latest offsets of each partition of the provided topics.
+	// It's convenient to add this as Reconciler field so that we can mock the function used during the
+	// reconciliation loop.
+	GetTopicsOffsetsFunc kafka.GetTopicsOffsetsFunc
+
+	// TopicsOffsetsCache is the cache for the topics offsets exposed in the consumer group status.
+	//
+	// Offsets are refreshed by the first reconciliation, usually a resync, after the cached entry expires,
+	// otherwise every status update would trigger a new reconciliation and a new status update.
+	TopicsOffsetsCache prober.Cache[string, prober.Status, struct{}]
+
+	// GetDeletedTopicsFunc returns the topics consumed by a consumer group, or with offsets committed by a consumer
+	// group, that don't exist in the Kafka cluster.
+	// It's convenient to add this as Reconciler field so that we can mock the function used during the
+	// reconciliation loop.
+	GetDeletedTopicsFunc kafka.GetDeletedTopicsFunc
+
+	// DeleteTopicsOffsetsFunc deletes the offsets committed by a consumer group for the provided topics.
+	// It's convenient to add this as Reconciler field so that we can mock the function used during the
+	// reconciliation loop.
+	DeleteTopicsOffsetsFunc kafka.DeleteTopicsOffsetsFunc
+
+	// DeletedTopicsCache is the cache for the deleted topics checks.
+	//
+	// Topics are checked only once the cached entry expires, so that consecutive checks observing a deleted
+	// topic are spaced by at least the cache expiration.
+	DeletedTopicsCache prober.Cache[string, prober.Status, struct{}]
+
+	// PendingDeletedTopicsCache is the cache for the deleted topics checks used in place of DeletedTopicsCache
+	// while some topics reported as deleted haven't been confirmed yet, so that they're checked again sooner.
+	PendingDeletedTopicsCache prober.Cache[string, prober.Status, struct{}]
+
+	// DeletedTopicsTracker tracks the topics reported as deleted by consecutive checks.
+	DeletedTopicsTracker *DeletedTopicsTracker
+
 	EnqueueKey func(key string)
 }
 
@@ -192,11 +234,18 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, cg *kafkainternals.Consu
 
 	r.reconcileStatusSelector(cg)
 
+	// Deleted topics are reconciled first, since their offsets can't be initialized.
+	logger.Debugw("Reconciling deleted topics")
+	r.reconcileDeletedTopics(ctx, cg)
+
 	logger.Debugw("Reconciling initial offset")
 	if err := r.reconcileInitialOffset(ctx, cg); err != nil {
 		return cg.MarkInitializeOffsetFailed("InitializeOffset", err)
 	}
 
+	logger.Debugw("Reconciling topics status")
+	r.reconcileTopicsStatus(ctx, cg)
+
 	logger.Debugw("Scheduling consumergroup")
 	if err := r.schedule(ctx, cg); err != nil {
 		return err
@@ -285,6 +334,10 @@ func (r *Reconciler) FinalizeKind(ctx context.Context, cg *kafkainternals.Consum
 	}
 
 	r.InitOffsetLatestInitialOffsetCache.Expire(keyOf(cg))
+	r.TopicsOffsetsCache.Expire(keyOf(cg))
+	r.DeletedTopicsCache.Expire(keyOf(cg))
+	r.PendingDeletedTopicsCache.Expire(keyOf(cg))
+	r.DeletedTopicsTracker.Forget(keyOf(cg))
 
 	logger.Debugw("Reconciliation succeeded (finalization)")
 
@@ -590,6 +643,12 @@ func (r *Reconciler) reconcileInitialOffset(ctx context.Context, cg *kafkaintern
 		return nil
 	}
 
+	// Offsets of topics deleted from the Kafka cluster can't be initialized, see reconcileDeletedTopics.
+	topics := existingTopics(cg)
+	if len(topics) == 0 {
+		return nil
+	}
+
 	if status, ok := r.InitOffsetLatestInitialOffsetCache.Get(keyOf(cg)); ok && status == prober.StatusReady {
 		return nil
 	}
@@ -614,7 +673,6 @@ func (r *Reconciler) reconcileInitialOffset(ctx context.Context, cg *kafkaintern
 	defer kafkaClient.Close()
 
 	groupId := cg.Spec.Template.Spec.Configs.Configs["group.id"]
-	topics := cg.Spec.Template.Spec.Topics
 
 	if _, err := r.InitOffsetsFunc(ctx, kafkaClient, kafkaClusterAdminClient, topics, groupId); err != nil {
 		return fmt.Errorf("failed to initialize offset: %w", err)
@@ -627,6 +685,255 @@ func (r *Reconciler) reconcileInitialOffset(ctx context.Context, cg *kafkaintern
 	return nil
 }
 
+// reconcileTopicsStatus sets the earliest and latest offsets of each partition of the topics consumed by a
+// KafkaSource consumer group.
+//
+// Failing to retrieve offsets doesn't fail the reconciliation since the topics status is informational only,
+// topics that can't be described because of ACLs are reported without partitions.
+// For the same reason, offsets are only retrieved for ready consumer groups and retrieving them is bounded by
+// topicsStatusTimeout, so that a slow or unreachable cluster doesn't delay the reconciliation.
+func (r *Reconciler) reconcileTopicsStatus(ctx context.Context, cg *kafkainternals.ConsumerGroup) {
+	if !Filter(KafkaSourceScheduler)(cg) || !cg.IsReady() {
+		return
+	}
+
+	if status, ok := r.TopicsOffsetsCache.Get(keyOf(cg)); ok && status == prober.StatusReady && hasTopicsStatus(cg) {
+		return
+	}
+
+	logger := logging.FromContext(ctx)
+
+	ctx, cancel := context.WithTimeout(ctx, topicsStatusTimeout)
+	defer cancel()
+
+	type result struct {
+		offsets map[string]map[int32]offset.PartitionOffsets
+		err     error
+	}
+	// Buffered, so that the goroutine doesn't leak when the timeout expires.
+	results := make(chan result, 1)
+	go func() {
+		offsets, err := r.getTopicsOffsets(ctx, cg)
+		results <- result{offsets: offsets, err: err}
+	}()
+
+	var offsets map[string]map[int32]offset.PartitionOffsets
+	select {
+	case res := <-results:
+		if res.err != nil {
+			logger.Warnw("Failed to get topics offsets, skipping topics status", zap.Error(res.err))
+			return
+		}
+		offsets = res.offsets
+	case <-ctx.Done():
+		logger.Warnw("Timed out getting topics offsets, skipping topics status", zap.Error(ctx.Err()))
+		return
+	}
+
+	deleted := deletedTopics(cg)
+	cg.Status.Topics = topicsStatus(cg.Spec.Template.Spec.Topics, offsets)
+	setTopicsDeleted(cg, deleted)
+
+	r.TopicsOffsetsCache.UpsertStatus(keyOf(cg), prober.StatusReady, struct{}{}, func(string, prober.Status, struct{}) {})
+}
+
+func (r *Reconciler) getTopicsOffsets(ctx context.Context, cg *kafkainternals.ConsumerGroup) (map[string]map[int32]offset.PartitionOffsets, error) {
+	kafkaSecret, err := r.newAuthSecret(ctx, cg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret for Kafka cluster auth: %w", err)
+	}
+
+	bootstrapServers := kafka.BootstrapServersArray(cg.Spec.Template.Spec.Configs.Configs["bootstrap.servers"])
+
+	kafkaClient, err := r.GetKafkaClient(ctx, bootstrapServers, kafkaSecret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kafka cluster client: %w", err)
+	}
+	defer kafkaClient.Close()
+
+	return r.GetTopicsOffsetsFunc(ctx, kafkaClient, cg.Spec.Template.Spec.Topics)
+}
+
+// hasTopicsStatus returns true when the status contains every topic consumed by the consumer group.
+func hasTopicsStatus(cg *kafkainternals.ConsumerGroup) bool {
+	if len(cg.Status.Topics) != len(cg.Spec.Template.Spec.Topics) {
+		return false
+	}
+	for i, t := range cg.Status.Topics {
+		if t.Name != cg.Spec.Template.Spec.Topics[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func topicsStatus(topics []string, offsets map[string]map[int32]offset.PartitionOffsets) []sources.TopicStatus {
+	var status []sources.TopicStatus
+	for _, topic := range topics {
+		partitionOffsets, ok := offsets[topic]
+		if !ok {
+			continue
+		}
+		t := sources.TopicStatus{Name: topic}
+		for partition, o := range partitionOffsets {
+			t.Partitions = append(t.Partitions, sources.PartitionStatus{
+				Partition:      partition,
+				EarliestOffset: o.Earliest,
+				LatestOffset:   o.Latest,
+			})
+		}
+		sort.Slice(t.Partitions, func(i, j int) bool { return t.Partitions[i].Partition < t.Partitions[j].Partition })
+		status = append(status, t)
+	}
+	return status
+}
+
+// reconcileDeletedTopics garbage collects the offsets committed by a KafkaSource consumer group for topics that
+// have been deleted from the Kafka cluster and flags the deleted topics in the consumer group status.
+// When every consumed topic has been deleted and the consumer group has no members, the consumer group is
+// deleted from the Kafka cluster.
+//
+// Only KafkaSource consumer groups are garbage collected, trigger and channel consumer groups are left as they are.
+//
+// Kafka doesn't allow deleting the offsets of a topic the consumer group is subscribed to, so the offsets of a
+// deleted topic still consumed by the running consumers are kept until the topic is removed from the
+// KafkaSource spec, or the consumer group has no members.
+//
+// To avoid acting on transient metadata gaps, a topic is considered deleted only once it has been reported
+// as deleted by multiple consecutive checks, see DeletedTopicsTracker. Checks are spaced by the
+// PendingDeletedTopicsCache expiration while a topic is being confirmed, and by the longer DeletedTopicsCache
+// expiration otherwise.
+// Failing to garbage collect offsets doesn't fail the reconciliation, it is retried on the next check.
+func (r *Reconciler) reconcileDeletedTopics(ctx context.Context, cg *kafkainternals.ConsumerGroup) {
+	if !Filter(KafkaSourceScheduler)(cg) {
+		return
+	}
+
+	if status, ok := r.DeletedTopicsCache.Get(keyOf(cg)); ok && status == prober.StatusReady {
+		return
+	}
+	if status, ok := r.PendingDeletedTopicsCache.Get(keyOf(cg)); ok && status == prober.StatusReady {
+		return
+	}
+
+	logger := logging.FromContext(ctx)
+
+	kafkaSecret, err := r.newAuthSecret(ctx, cg)
+	if err != nil {
+		logger.Warnw("Failed to get secret for Kafka cluster auth, skipping deleted topics", zap.Error(err))
+		return
+	}
+
+	bootstrapServers := kafka.BootstrapServersArray(cg.Spec.Template.Spec.Configs.Configs["bootstrap.servers"])
+
+	kafkaClusterAdminClient, err := r.GetKafkaClusterAdmin(ctx, bootstrapServers, kafkaSecret)
+	if err != nil {
+		logger.Warnw("Failed to create Kafka cluster admin, skipping deleted topics", zap.Error(err))
+		return
+	}
+	defer kafkaClusterAdminClient.Close()
+
+	groupId := cg.Spec.Template.Spec.Configs.Configs["group.id"]
+	topics := cg.Spec.Template.Spec.Topics
+
+	reported, err := r.GetDeletedTopicsFunc(ctx, kafkaClusterAdminClient, topics, groupId)
+	if err != nil {
+		// A failed check doesn't prove anything, previous observations are kept as they are.
+		logger.Warnw("Failed to get deleted topics, skipping deleted topics", zap.Error(err))
+		return
+	}
+
+	previous := deletedTopics(cg)
+	confirmed := sets.New[string](r.DeletedTopicsTracker.Observe(keyOf(cg), reported)...)
+	// Topics already flagged are kept as long as they're reported as deleted, for example when the tracker
+	// observations have been lost by a restart.
+	confirmed = confirmed.Union(previous.Intersection(sets.New[string](reported...)))
+	setTopicsDeleted(cg, confirmed)
+	if previous.Difference(confirmed).Len() > 0 {
+		// Topics created again need their offsets to be initialized.
+		r.InitOffsetLatestInitialOffsetCache.Expire(keyOf(cg))
+	}
+
+	deleted := sets.List(confirmed)
+	if len(deleted) > 0 {
+		logger.Debugw("Garbage collecting offsets of deleted topics", zap.Strings("topics", deleted), zap.String("group.id", groupId))
+
+		err := r.deleteTopicsOffsets(ctx, kafkaClusterAdminClient, cg, deleted)
+		if errorIsOneOf(err, sarama.ErrGroupSubscribedToTopic) {
+			// Expected while the deleted topics are still in the spec, they're flagged as deleted in the status.
+			logger.Debugw("Offsets of deleted topics still subscribed are kept", zap.Strings("topics", deleted), zap.Error(err))
+		} else if err != nil {
+			logger.Warnw("Failed to garbage collect offsets of deleted topics", zap.Strings("topics", deleted), zap.Error(err))
+		}
+	}
+
+	cache := r.DeletedTopicsCache
+	if r.DeletedTopicsTracker.HasPending(keyOf(cg)) {
+		cache = r.PendingDeletedTopicsCache
+	}
+	cache.UpsertStatus(keyOf(cg), prober.StatusReady, struct{}{}, func(key string, _ prober.Status, _ struct{}) {
+		r.EnqueueKey(key)
+	})
+}
+
+func (r *Reconciler) deleteTopicsOffsets(ctx context.Context, kafkaClusterAdminClient sarama.ClusterAdmin, cg *kafkainternals.ConsumerGroup, deleted []string) error {
+	groupId := cg.Spec.Template.Spec.Configs.Configs["group.id"]
+
+	if len(cg.Spec.Template.Spec.Topics) > 0 && sets.New[string](deleted...).HasAll(cg.Spec.Template.Spec.Topics...) {
+		// The consumer group has members as long as its consumers are running, in which case only
+		// the offsets are deleted.
+		err := kafkaClusterAdminClient.DeleteConsumerGroup(groupId)
+		if err == nil || errorIsOneOf(err, sarama.ErrGroupIDNotFound) {
+			logging.FromContext(ctx).Debugw("Deleted consumer group of deleted topics", zap.String("group.id", groupId))
+			return nil
+		}
+		if !errorIsOneOf(err, sarama.ErrNonEmptyGroup) {
+			return fmt.Errorf("unable to delete the consumer group %s: %w", groupId, err)
+		}
+	}
+
+	return r.DeleteTopicsOffsetsFunc(ctx, kafkaClusterAdminClient, deleted, groupId)
+}
+
+// deletedTopics returns the topics flagged as deleted in the consumer group status.
+func deletedTopics(cg *kafkainternals.ConsumerGroup) sets.Set[string] {
+	deleted := sets.New[string]()
+	for _, t := range cg.Status.Topics {
+		if t.Deleted {
+			deleted.Insert(t.Name)
+		}
+	}
+	return deleted
+}
+
+// existingTopics returns the topics consumed by the consumer group that aren't flagged as deleted in the
+// consumer group status.
+func existingTopics(cg *kafkainternals.ConsumerGroup) []string {
+	deleted := deletedTopics(cg)
+	topics := make([]string, 0, len(cg.Spec.Template.Spec.Topics))
+	for _, t := range cg.Spec.Template.Spec.Topics {
+		if !deleted.Has(t) {
+			topics = append(topics, t)
+		}
+	}
+	return topics
+}
+
+// setTopicsDeleted flags the provided topics as deleted in the consumer group status, adding the deleted
+// topics consumed by the consumer group that aren't in the status yet.
+func setTopicsDeleted(cg *kafkainternals.ConsumerGroup, deleted sets.Set[string]) {
+	known := sets.New[string]()
+	for i := range cg.Status.Topics {
+		cg.Status.Topics[i].Deleted = deleted.Has(cg.Status.Topics[i].Name)
+		known.Insert(cg.Status.Topics[i].Name)
+	}
+	for _, t := range cg.Spec.Template.Spec.Topics {
+		if deleted.Has(t) && !known.Has(t) {
+			cg.Status.Topics = append(cg.Status.Topics, sources.TopicStatus{Name: t, Deleted: true})
+		}
+	}
+}
+
 func (r *Reconciler) reconcileKedaObjects(ctx context.Context, cg *kafkainternals.ConsumerGroup) error {
 	var triggerAuthentication *kedav1alpha1.TriggerAuthentication
 	var secret *corev1.Secret
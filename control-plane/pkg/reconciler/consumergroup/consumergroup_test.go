@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/IBM/sarama"
+	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -51,6 +52,8 @@ import (
 	"knative.dev/eventing-kafka-broker/control-plane/pkg/counter"
 
 	"knative.dev/eventing-kafka-broker/control-plane/pkg/config"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/kafka"
+	"knative.dev/eventing-kafka-broker/control-plane/pkg/kafka/offset"
 	kafkatesting "knative.dev/eventing-kafka-broker/control-plane/pkg/kafka/testing"
 
 	configapis "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/config"
@@ -68,8 +71,15 @@ func (f SchedulerFunc) Schedule(ctx context.Context, vpod scheduler.VPod) ([]eve
 }
 
 const (
-	testSchedulerKey = "scheduler"
-	noTestScheduler  = "no-scheduler"
+	testSchedulerKey     = "scheduler"
+	noTestScheduler      = "no-scheduler"
+	testTopicsOffsetsKey = "topicsOffsets"
+
+	testDeletedTopicsKey             = "deletedTopics"
+	testDeletedTopicsObservationsKey = "deletedTopicsObservations"
+	testDeletedTopicsOffsetsKey      = "deletedTopicsOffsets"
+	testDeleteTopicsOffsetsErrorKey  = "deleteTopicsOffsetsError"
+	testInitOffsetsKey               = "initOffsets"
 
 	systemNamespace = "knative-eventing"
 	finalizerName   = "consumergroups.internal.kafka.eventing.knative.dev"
@@ -82,10 +92,644 @@ var finalizerUpdatedEvent = Eventf(
 )
 
 func TestReconcileKind(t *testing.T) {
+	defaultTopicsStatusTimeout := topicsStatusTimeout
+	topicsStatusTimeout = 100 * time.Millisecond
+	t.Cleanup(func() { topicsStatusTimeout = defaultTopicsStatusTimeout })
+
 	//TODO: Add tests with KEDA installed
 	tt := TableTest{
 		{
-			Name: "Consumers in multiple pods",
+			Name: "Consumers in multiple pods",
+			Objects: []runtime.Object{
+				NewService(),
+				NewConsumerGroup(
+					ConsumerGroupConsumerSpec(NewConsumerSpec(
+						ConsumerTopics("t1", "t2"),
+						ConsumerConfigs(
+							ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+							ConsumerGroupIdConfig("my.group.id"),
+						),
+					)),
+					ConsumerGroupReplicas(2),
+					ConsumerForTrigger(),
+				),
+			},
+			Key: ConsumerGroupTestKey,
+			OtherTestData: map[string]interface{}{
+				testSchedulerKey: SchedulerFunc(func(_ context.Context, vpod scheduler.VPod) ([]eventingduckv1alpha1.Placement, error) {
+					return []eventingduckv1alpha1.Placement{
+						{PodName: "p1", VReplicas: 1},
+						{PodName: "p2", VReplicas: 1},
+					}, nil
+				}),
+			},
+			WantCreates: []runtime.Object{
+				NewConsumer(1,
+					ConsumerFinalizer(),
+					ConsumerSpec(NewConsumerSpec(
+						ConsumerTopics("t1", "t2"),
+						ConsumerConfigs(
+							ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+							ConsumerGroupIdConfig("my.group.id"),
+						),
+						ConsumerVReplicas(1),
+						ConsumerPlacement(kafkainternals.PodBind{PodName: "p1", PodNamespace: systemNamespace}),
+					)),
+				),
+				NewConsumer(2,
+					ConsumerFinalizer(),
+					ConsumerSpec(NewConsumerSpec(
+						ConsumerTopics("t1", "t2"),
+						ConsumerConfigs(
+							ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+							ConsumerGroupIdConfig("my.group.id"),
+						),
+						ConsumerVReplicas(1),
+						ConsumerPlacement(kafkainternals.PodBind{PodName: "p2", PodNamespace: systemNamespace}),
+					)),
+				),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: func() runtime.Object {
+						cg := NewConsumerGroup(
+							ConsumerGroupConsumerSpec(NewConsumerSpec(
+								ConsumerTopics("t1", "t2"),
+								ConsumerConfigs(
+									ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+									ConsumerGroupIdConfig("my.group.id"),
+								),
+							)),
+							ConsumerGroupReplicas(2),
+							ConsumerGroupStatusReplicas(0),
+							ConsumerForTrigger(),
+							ConsumerGroupStatusSelector(ConsumerLabels),
+						)
+						cg.Status.Placements = []eventingduckv1alpha1.Placement{
+							{PodName: "p1", VReplicas: 1},
+							{PodName: "p2", VReplicas: 1},
+						}
+						_ = cg.MarkReconcileConsumersFailed("PropagateSubscriberURI", ErrNoSubscriberURI)
+						cg.MarkScheduleSucceeded()
+						cg.MarkAutoscalerDisabled() // KEDA not installed
+						return cg
+					}(),
+				},
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+		},
+		{
+			Name: "Consumers for source, topics offsets in status",
+			Objects: []runtime.Object{
+				NewService(),
+				NewConsumerGroup(
+					ConsumerGroupConsumerSpec(NewConsumerSpec(
+						ConsumerTopics("t1", "t2"),
+						ConsumerConfigs(
+							ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+							ConsumerGroupIdConfig("my.group.id"),
+						),
+					)),
+					ConsumerGroupReplicas(2),
+					ConsumerGroupOwnerRef(SourceAsOwnerReference()),
+					ConsumerGroupReady,
+				),
+			},
+			Key: ConsumerGroupTestKey,
+			OtherTestData: map[string]interface{}{
+				testTopicsOffsetsKey: kafka.GetTopicsOffsetsFunc(func(_ context.Context, _ sarama.Client, topics []string) (map[string]map[int32]offset.PartitionOffsets, error) {
+					return map[string]map[int32]offset.PartitionOffsets{
+						"t1": {
+							1: {Earliest: 5, Latest: 20},
+							0: {Earliest: 0, Latest: 10},
+						},
+						// not authorized to describe t2
+						"t2": nil,
+					}, nil
+				}),
+				testSchedulerKey: SchedulerFunc(func(_ context.Context, vpod scheduler.VPod) ([]eventingduckv1alpha1.Placement, error) {
+					return []eventingduckv1alpha1.Placement{
+						{PodName: "p1", VReplicas: 1},
+						{PodName: "p2", VReplicas: 1},
+					}, nil
+				}),
+			},
+			WantCreates: []runtime.Object{
+				NewConsumer(1,
+					ConsumerFinalizer(),
+					ConsumerSpec(NewConsumerSpec(
+						ConsumerTopics("t1", "t2"),
+						ConsumerConfigs(
+							ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+							ConsumerGroupIdConfig("my.group.id"),
+						),
+						ConsumerVReplicas(1),
+						ConsumerPlacement(kafkainternals.PodBind{PodName: "p1", PodNamespace: systemNamespace}),
+					)),
+				),
+				NewConsumer(2,
+					ConsumerFinalizer(),
+					ConsumerSpec(NewConsumerSpec(
+						ConsumerTopics("t1", "t2"),
+						ConsumerConfigs(
+							ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+							ConsumerGroupIdConfig("my.group.id"),
+						),
+						ConsumerVReplicas(1),
+						ConsumerPlacement(kafkainternals.PodBind{PodName: "p2", PodNamespace: systemNamespace}),
+					)),
+				),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: func() runtime.Object {
+						cg := NewConsumerGroup(
+							ConsumerGroupConsumerSpec(NewConsumerSpec(
+								ConsumerTopics("t1", "t2"),
+								ConsumerConfigs(
+									ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+									ConsumerGroupIdConfig("my.group.id"),
+								),
+							)),
+							ConsumerGroupReplicas(2),
+							ConsumerGroupStatusReplicas(0),
+							ConsumerGroupOwnerRef(SourceAsOwnerReference()),
+							ConsumerGroupStatusSelector(ConsumerLabels),
+						)
+						cg.Status.Topics = []sources.TopicStatus{
+							{
+								Name: "t1",
+								Partitions: []sources.PartitionStatus{
+									{Partition: 0, EarliestOffset: 0, LatestOffset: 10},
+									{Partition: 1, EarliestOffset: 5, LatestOffset: 20},
+								},
+							},
+							{Name: "t2"},
+						}
+						cg.Status.Placements = []eventingduckv1alpha1.Placement{
+							{PodName: "p1", VReplicas: 1},
+							{PodName: "p2", VReplicas: 1},
+						}
+						_ = cg.MarkReconcileConsumersFailed("PropagateSubscriberURI", ErrNoSubscriberURI)
+						cg.MarkScheduleSucceeded()
+						cg.MarkAutoscalerDisabled() // KEDA not installed
+						return cg
+					}(),
+				},
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+		},
+		{
+			Name: "Consumers for source, not ready, topics offsets not retrieved",
+			Objects: []runtime.Object{
+				NewService(),
+				NewConsumerGroup(
+					ConsumerGroupConsumerSpec(NewConsumerSpec(
+						ConsumerTopics("t1", "t2"),
+						ConsumerConfigs(
+							ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+							ConsumerGroupIdConfig("my.group.id"),
+						),
+					)),
+					ConsumerGroupReplicas(2),
+					ConsumerGroupOwnerRef(SourceAsOwnerReference()),
+				),
+			},
+			Key: ConsumerGroupTestKey,
+			OtherTestData: map[string]interface{}{
+				testTopicsOffsetsKey: kafka.GetTopicsOffsetsFunc(func(_ context.Context, _ sarama.Client, topics []string) (map[string]map[int32]offset.PartitionOffsets, error) {
+					return map[string]map[int32]offset.PartitionOffsets{
+						"t1": {
+							1: {Earliest: 5, Latest: 20},
+							0: {Earliest: 0, Latest: 10},
+						},
+						// not authorized to describe t2
+						"t2": nil,
+					}, nil
+				}),
+				testSchedulerKey: SchedulerFunc(func(_ context.Context, vpod scheduler.VPod) ([]eventingduckv1alpha1.Placement, error) {
+					return []eventingduckv1alpha1.Placement{
+						{PodName: "p1", VReplicas: 1},
+						{PodName: "p2", VReplicas: 1},
+					}, nil
+				}),
+			},
+			WantCreates: []runtime.Object{
+				NewConsumer(1,
+					ConsumerFinalizer(),
+					ConsumerSpec(NewConsumerSpec(
+						ConsumerTopics("t1", "t2"),
+						ConsumerConfigs(
+							ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+							ConsumerGroupIdConfig("my.group.id"),
+						),
+						ConsumerVReplicas(1),
+						ConsumerPlacement(kafkainternals.PodBind{PodName: "p1", PodNamespace: systemNamespace}),
+					)),
+				),
+				NewConsumer(2,
+					ConsumerFinalizer(),
+					ConsumerSpec(NewConsumerSpec(
+						ConsumerTopics("t1", "t2"),
+						ConsumerConfigs(
+							ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+							ConsumerGroupIdConfig("my.group.id"),
+						),
+						ConsumerVReplicas(1),
+						ConsumerPlacement(kafkainternals.PodBind{PodName: "p2", PodNamespace: systemNamespace}),
+					)),
+				),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: func() runtime.Object {
+						cg := NewConsumerGroup(
+							ConsumerGroupConsumerSpec(NewConsumerSpec(
+								ConsumerTopics("t1", "t2"),
+								ConsumerConfigs(
+									ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+									ConsumerGroupIdConfig("my.group.id"),
+								),
+							)),
+							ConsumerGroupReplicas(2),
+							ConsumerGroupStatusReplicas(0),
+							ConsumerGroupOwnerRef(SourceAsOwnerReference()),
+							ConsumerGroupStatusSelector(ConsumerLabels),
+						)
+						cg.Status.Placements = []eventingduckv1alpha1.Placement{
+							{PodName: "p1", VReplicas: 1},
+							{PodName: "p2", VReplicas: 1},
+						}
+						_ = cg.MarkReconcileConsumersFailed("PropagateSubscriberURI", ErrNoSubscriberURI)
+						cg.MarkScheduleSucceeded()
+						cg.MarkAutoscalerDisabled() // KEDA not installed
+						return cg
+					}(),
+				},
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+		},
+		{
+			Name: "Consumers for source, timed out retrieving topics offsets",
+			Objects: []runtime.Object{
+				NewService(),
+				NewConsumerGroup(
+					ConsumerGroupConsumerSpec(NewConsumerSpec(
+						ConsumerTopics("t1", "t2"),
+						ConsumerConfigs(
+							ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+							ConsumerGroupIdConfig("my.group.id"),
+						),
+					)),
+					ConsumerGroupReplicas(2),
+					ConsumerGroupOwnerRef(SourceAsOwnerReference()),
+					ConsumerGroupReady,
+				),
+			},
+			Key: ConsumerGroupTestKey,
+			OtherTestData: map[string]interface{}{
+				testTopicsOffsetsKey: kafka.GetTopicsOffsetsFunc(func(ctx context.Context, _ sarama.Client, topics []string) (map[string]map[int32]offset.PartitionOffsets, error) {
+					<-ctx.Done()
+					return nil, ctx.Err()
+				}),
+				testSchedulerKey: SchedulerFunc(func(_ context.Context, vpod scheduler.VPod) ([]eventingduckv1alpha1.Placement, error) {
+					return []eventingduckv1alpha1.Placement{
+						{PodName: "p1", VReplicas: 1},
+						{PodName: "p2", VReplicas: 1},
+					}, nil
+				}),
+			},
+			WantCreates: []runtime.Object{
+				NewConsumer(1,
+					ConsumerFinalizer(),
+					ConsumerSpec(NewConsumerSpec(
+						ConsumerTopics("t1", "t2"),
+						ConsumerConfigs(
+							ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+							ConsumerGroupIdConfig("my.group.id"),
+						),
+						ConsumerVReplicas(1),
+						ConsumerPlacement(kafkainternals.PodBind{PodName: "p1", PodNamespace: systemNamespace}),
+					)),
+				),
+				NewConsumer(2,
+					ConsumerFinalizer(),
+					ConsumerSpec(NewConsumerSpec(
+						ConsumerTopics("t1", "t2"),
+						ConsumerConfigs(
+							ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+							ConsumerGroupIdConfig("my.group.id"),
+						),
+						ConsumerVReplicas(1),
+						ConsumerPlacement(kafkainternals.PodBind{PodName: "p2", PodNamespace: systemNamespace}),
+					)),
+				),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: func() runtime.Object {
+						cg := NewConsumerGroup(
+							ConsumerGroupConsumerSpec(NewConsumerSpec(
+								ConsumerTopics("t1", "t2"),
+								ConsumerConfigs(
+									ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+									ConsumerGroupIdConfig("my.group.id"),
+								),
+							)),
+							ConsumerGroupReplicas(2),
+							ConsumerGroupStatusReplicas(0),
+							ConsumerGroupOwnerRef(SourceAsOwnerReference()),
+							ConsumerGroupStatusSelector(ConsumerLabels),
+						)
+						cg.Status.Placements = []eventingduckv1alpha1.Placement{
+							{PodName: "p1", VReplicas: 1},
+							{PodName: "p2", VReplicas: 1},
+						}
+						_ = cg.MarkReconcileConsumersFailed("PropagateSubscriberURI", ErrNoSubscriberURI)
+						cg.MarkScheduleSucceeded()
+						cg.MarkAutoscalerDisabled() // KEDA not installed
+						return cg
+					}(),
+				},
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+		},
+		{
+			Name: "Consumers for source, deleted topic garbage collected",
+			Objects: []runtime.Object{
+				NewService(),
+				NewConsumerGroup(
+					ConsumerGroupConsumerSpec(NewConsumerSpec(
+						ConsumerTopics("t1", "t2"),
+						ConsumerConfigs(
+							ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+							ConsumerGroupIdConfig("my.group.id"),
+						),
+					)),
+					ConsumerGroupReplicas(2),
+					ConsumerGroupOwnerRef(SourceAsOwnerReference()),
+					ConsumerGroupReady,
+				),
+			},
+			Key: ConsumerGroupTestKey,
+			OtherTestData: map[string]interface{}{
+				testTopicsOffsetsKey: kafka.GetTopicsOffsetsFunc(func(_ context.Context, _ sarama.Client, topics []string) (map[string]map[int32]offset.PartitionOffsets, error) {
+					return map[string]map[int32]offset.PartitionOffsets{
+						"t1": {
+							1: {Earliest: 5, Latest: 20},
+							0: {Earliest: 0, Latest: 10},
+						},
+						// t2 doesn't exist
+						"t2": nil,
+					}, nil
+				}),
+				testDeletedTopicsKey: kafka.GetDeletedTopicsFunc(func(_ context.Context, _ sarama.ClusterAdmin, topics []string, consumerGroup string) ([]string, error) {
+					return []string{"t2"}, nil
+				}),
+				testDeletedTopicsObservationsKey: [][]string{{"t2"}, {"t2"}},
+				testDeletedTopicsOffsetsKey:      &[]string{},
+				testSchedulerKey: SchedulerFunc(func(_ context.Context, vpod scheduler.VPod) ([]eventingduckv1alpha1.Placement, error) {
+					return []eventingduckv1alpha1.Placement{
+						{PodName: "p1", VReplicas: 1},
+						{PodName: "p2", VReplicas: 1},
+					}, nil
+				}),
+			},
+			WantCreates: []runtime.Object{
+				NewConsumer(1,
+					ConsumerFinalizer(),
+					ConsumerSpec(NewConsumerSpec(
+						ConsumerTopics("t1", "t2"),
+						ConsumerConfigs(
+							ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+							ConsumerGroupIdConfig("my.group.id"),
+						),
+						ConsumerVReplicas(1),
+						ConsumerPlacement(kafkainternals.PodBind{PodName: "p1", PodNamespace: systemNamespace}),
+					)),
+				),
+				NewConsumer(2,
+					ConsumerFinalizer(),
+					ConsumerSpec(NewConsumerSpec(
+						ConsumerTopics("t1", "t2"),
+						ConsumerConfigs(
+							ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+							ConsumerGroupIdConfig("my.group.id"),
+						),
+						ConsumerVReplicas(1),
+						ConsumerPlacement(kafkainternals.PodBind{PodName: "p2", PodNamespace: systemNamespace}),
+					)),
+				),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: func() runtime.Object {
+						cg := NewConsumerGroup(
+							ConsumerGroupConsumerSpec(NewConsumerSpec(
+								ConsumerTopics("t1", "t2"),
+								ConsumerConfigs(
+									ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+									ConsumerGroupIdConfig("my.group.id"),
+								),
+							)),
+							ConsumerGroupReplicas(2),
+							ConsumerGroupStatusReplicas(0),
+							ConsumerGroupOwnerRef(SourceAsOwnerReference()),
+							ConsumerGroupStatusSelector(ConsumerLabels),
+						)
+						cg.Status.Topics = []sources.TopicStatus{
+							{
+								Name: "t1",
+								Partitions: []sources.PartitionStatus{
+									{Partition: 0, EarliestOffset: 0, LatestOffset: 10},
+									{Partition: 1, EarliestOffset: 5, LatestOffset: 20},
+								},
+							},
+							{Name: "t2", Deleted: true},
+						}
+						cg.Status.Placements = []eventingduckv1alpha1.Placement{
+							{PodName: "p1", VReplicas: 1},
+							{PodName: "p2", VReplicas: 1},
+						}
+						_ = cg.MarkReconcileConsumersFailed("PropagateSubscriberURI", ErrNoSubscriberURI)
+						cg.MarkScheduleSucceeded()
+						cg.MarkAutoscalerDisabled() // KEDA not installed
+						return cg
+					}(),
+				},
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			PostConditions: []func(*testing.T, *TableRow){
+				func(t *testing.T, row *TableRow) {
+					deleted := row.OtherTestData[testDeletedTopicsOffsetsKey].(*[]string)
+					if diff := cmp.Diff([]string{"t2"}, *deleted); diff != "" {
+						t.Errorf("unexpected garbage collected topics (-want, +got) %s", diff)
+					}
+				},
+			},
+		},
+		{
+			Name: "Consumers for source, initial offset latest, deleted topic not initialized",
+			Objects: []runtime.Object{
+				NewService(),
+				NewConsumerGroup(
+					ConsumerGroupConsumerSpec(NewConsumerSpec(
+						ConsumerTopics("t1", "t2"),
+						ConsumerConfigs(
+							ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+							ConsumerGroupIdConfig("my.group.id"),
+						),
+						ConsumerDelivery(NewConsumerSpecDelivery("", ConsumerInitialOffset(sources.OffsetLatest))),
+					)),
+					ConsumerGroupReplicas(2),
+					ConsumerGroupOwnerRef(SourceAsOwnerReference()),
+					ConsumerGroupReady,
+					ConsumerGroupStatusTopics(
+						sources.TopicStatus{Name: "t1"},
+						sources.TopicStatus{Name: "t2"},
+					),
+				),
+			},
+			Key: ConsumerGroupTestKey,
+			OtherTestData: map[string]interface{}{
+				testTopicsOffsetsKey: kafka.GetTopicsOffsetsFunc(func(_ context.Context, _ sarama.Client, topics []string) (map[string]map[int32]offset.PartitionOffsets, error) {
+					return map[string]map[int32]offset.PartitionOffsets{
+						"t1": {
+							1: {Earliest: 5, Latest: 20},
+							0: {Earliest: 0, Latest: 10},
+						},
+						// t2 doesn't exist
+						"t2": nil,
+					}, nil
+				}),
+				testDeletedTopicsKey: kafka.GetDeletedTopicsFunc(func(_ context.Context, _ sarama.ClusterAdmin, topics []string, consumerGroup string) ([]string, error) {
+					return []string{"t2"}, nil
+				}),
+				testDeletedTopicsObservationsKey: [][]string{{"t2"}, {"t2"}},
+				testDeletedTopicsOffsetsKey:      &[]string{},
+				testInitOffsetsKey: kafka.InitOffsetsFunc(func(_ context.Context, _ sarama.Client, _ sarama.ClusterAdmin, topics []string, _ string) (int32, error) {
+					for _, t := range topics {
+						if t == "t2" {
+							return 0, fmt.Errorf("failed to get partitions of topic %s: %w", t, sarama.ErrUnknownTopicOrPartition)
+						}
+					}
+					return 1, nil
+				}),
+				testSchedulerKey: SchedulerFunc(func(_ context.Context, vpod scheduler.VPod) ([]eventingduckv1alpha1.Placement, error) {
+					return []eventingduckv1alpha1.Placement{
+						{PodName: "p1", VReplicas: 1},
+						{PodName: "p2", VReplicas: 1},
+					}, nil
+				}),
+			},
+			WantCreates: []runtime.Object{
+				NewConsumer(1,
+					ConsumerFinalizer(),
+					ConsumerSpec(NewConsumerSpec(
+						ConsumerTopics("t1", "t2"),
+						ConsumerConfigs(
+							ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+							ConsumerGroupIdConfig("my.group.id"),
+						),
+						ConsumerDelivery(NewConsumerSpecDelivery("", ConsumerInitialOffset(sources.OffsetLatest))),
+						ConsumerVReplicas(1),
+						ConsumerPlacement(kafkainternals.PodBind{PodName: "p1", PodNamespace: systemNamespace}),
+					)),
+				),
+				NewConsumer(2,
+					ConsumerFinalizer(),
+					ConsumerSpec(NewConsumerSpec(
+						ConsumerTopics("t1", "t2"),
+						ConsumerConfigs(
+							ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+							ConsumerGroupIdConfig("my.group.id"),
+						),
+						ConsumerDelivery(NewConsumerSpecDelivery("", ConsumerInitialOffset(sources.OffsetLatest))),
+						ConsumerVReplicas(1),
+						ConsumerPlacement(kafkainternals.PodBind{PodName: "p2", PodNamespace: systemNamespace}),
+					)),
+				),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: func() runtime.Object {
+						cg := NewConsumerGroup(
+							ConsumerGroupConsumerSpec(NewConsumerSpec(
+								ConsumerTopics("t1", "t2"),
+								ConsumerConfigs(
+									ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+									ConsumerGroupIdConfig("my.group.id"),
+								),
+								ConsumerDelivery(NewConsumerSpecDelivery("", ConsumerInitialOffset(sources.OffsetLatest))),
+							)),
+							ConsumerGroupReplicas(2),
+							ConsumerGroupStatusReplicas(0),
+							ConsumerGroupOwnerRef(SourceAsOwnerReference()),
+							ConsumerGroupStatusSelector(ConsumerLabels),
+						)
+						cg.Status.Topics = []sources.TopicStatus{
+							{
+								Name: "t1",
+								Partitions: []sources.PartitionStatus{
+									{Partition: 0, EarliestOffset: 0, LatestOffset: 10},
+									{Partition: 1, EarliestOffset: 5, LatestOffset: 20},
+								},
+							},
+							{Name: "t2", Deleted: true},
+						}
+						cg.Status.Placements = []eventingduckv1alpha1.Placement{
+							{PodName: "p1", VReplicas: 1},
+							{PodName: "p2", VReplicas: 1},
+						}
+						_ = cg.MarkReconcileConsumersFailed("PropagateSubscriberURI", ErrNoSubscriberURI)
+						cg.MarkScheduleSucceeded()
+						cg.MarkAutoscalerDisabled() // KEDA not installed
+						return cg
+					}(),
+				},
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			PostConditions: []func(*testing.T, *TableRow){
+				func(t *testing.T, row *TableRow) {
+					deleted := row.OtherTestData[testDeletedTopicsOffsetsKey].(*[]string)
+					if diff := cmp.Diff([]string{"t2"}, *deleted); diff != "" {
+						t.Errorf("unexpected garbage collected topics (-want, +got) %s", diff)
+					}
+				},
+			},
+		},
+		{
+			Name: "Consumers for source, deleted topic subscribed by the consumer group",
 			Objects: []runtime.Object{
 				NewService(),
 				NewConsumerGroup(
@@ -97,11 +741,27 @@ func TestReconcileKind(t *testing.T) {
 						),
 					)),
 					ConsumerGroupReplicas(2),
-					ConsumerForTrigger(),
+					ConsumerGroupOwnerRef(SourceAsOwnerReference()),
+					ConsumerGroupReady,
 				),
 			},
 			Key: ConsumerGroupTestKey,
 			OtherTestData: map[string]interface{}{
+				testTopicsOffsetsKey: kafka.GetTopicsOffsetsFunc(func(_ context.Context, _ sarama.Client, topics []string) (map[string]map[int32]offset.PartitionOffsets, error) {
+					return map[string]map[int32]offset.PartitionOffsets{
+						"t1": {
+							1: {Earliest: 5, Latest: 20},
+							0: {Earliest: 0, Latest: 10},
+						},
+						// t2 doesn't exist
+						"t2": nil,
+					}, nil
+				}),
+				testDeletedTopicsKey: kafka.GetDeletedTopicsFunc(func(_ context.Context, _ sarama.ClusterAdmin, topics []string, consumerGroup string) ([]string, error) {
+					return []string{"t2"}, nil
+				}),
+				testDeletedTopicsObservationsKey: [][]string{{"t2"}, {"t2"}},
+				testDeleteTopicsOffsetsErrorKey:  fmt.Errorf("consumer group my.group.id is subscribed to topics [t2]: %w", sarama.ErrGroupSubscribedToTopic),
 				testSchedulerKey: SchedulerFunc(func(_ context.Context, vpod scheduler.VPod) ([]eventingduckv1alpha1.Placement, error) {
 					return []eventingduckv1alpha1.Placement{
 						{PodName: "p1", VReplicas: 1},
@@ -148,7 +808,224 @@ func TestReconcileKind(t *testing.T) {
 							)),
 							ConsumerGroupReplicas(2),
 							ConsumerGroupStatusReplicas(0),
-							ConsumerForTrigger(),
+							ConsumerGroupOwnerRef(SourceAsOwnerReference()),
+							ConsumerGroupStatusSelector(ConsumerLabels),
+						)
+						cg.Status.Topics = []sources.TopicStatus{
+							{
+								Name: "t1",
+								Partitions: []sources.PartitionStatus{
+									{Partition: 0, EarliestOffset: 0, LatestOffset: 10},
+									{Partition: 1, EarliestOffset: 5, LatestOffset: 20},
+								},
+							},
+							{Name: "t2", Deleted: true},
+						}
+						cg.Status.Placements = []eventingduckv1alpha1.Placement{
+							{PodName: "p1", VReplicas: 1},
+							{PodName: "p2", VReplicas: 1},
+						}
+						_ = cg.MarkReconcileConsumersFailed("PropagateSubscriberURI", ErrNoSubscriberURI)
+						cg.MarkScheduleSucceeded()
+						cg.MarkAutoscalerDisabled() // KEDA not installed
+						return cg
+					}(),
+				},
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+		},
+		{
+			Name: "Consumers for source, transiently missing topic not garbage collected",
+			Objects: []runtime.Object{
+				NewService(),
+				NewConsumerGroup(
+					ConsumerGroupConsumerSpec(NewConsumerSpec(
+						ConsumerTopics("t1", "t2"),
+						ConsumerConfigs(
+							ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+							ConsumerGroupIdConfig("my.group.id"),
+						),
+					)),
+					ConsumerGroupReplicas(2),
+					ConsumerGroupOwnerRef(SourceAsOwnerReference()),
+					ConsumerGroupReady,
+				),
+			},
+			Key: ConsumerGroupTestKey,
+			OtherTestData: map[string]interface{}{
+				testTopicsOffsetsKey: kafka.GetTopicsOffsetsFunc(func(_ context.Context, _ sarama.Client, topics []string) (map[string]map[int32]offset.PartitionOffsets, error) {
+					return map[string]map[int32]offset.PartitionOffsets{
+						"t1": {
+							1: {Earliest: 5, Latest: 20},
+							0: {Earliest: 0, Latest: 10},
+						},
+						// t2 doesn't exist
+						"t2": nil,
+					}, nil
+				}),
+				testDeletedTopicsKey: kafka.GetDeletedTopicsFunc(func(_ context.Context, _ sarama.ClusterAdmin, topics []string, consumerGroup string) ([]string, error) {
+					return []string{"t2"}, nil
+				}),
+				testDeletedTopicsObservationsKey: [][]string{{"t2"}, nil},
+				testDeletedTopicsOffsetsKey:      &[]string{},
+				testSchedulerKey: SchedulerFunc(func(_ context.Context, vpod scheduler.VPod) ([]eventingduckv1alpha1.Placement, error) {
+					return []eventingduckv1alpha1.Placement{
+						{PodName: "p1", VReplicas: 1},
+						{PodName: "p2", VReplicas: 1},
+					}, nil
+				}),
+			},
+			WantCreates: []runtime.Object{
+				NewConsumer(1,
+					ConsumerFinalizer(),
+					ConsumerSpec(NewConsumerSpec(
+						ConsumerTopics("t1", "t2"),
+						ConsumerConfigs(
+							ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+							ConsumerGroupIdConfig("my.group.id"),
+						),
+						ConsumerVReplicas(1),
+						ConsumerPlacement(kafkainternals.PodBind{PodName: "p1", PodNamespace: systemNamespace}),
+					)),
+				),
+				NewConsumer(2,
+					ConsumerFinalizer(),
+					ConsumerSpec(NewConsumerSpec(
+						ConsumerTopics("t1", "t2"),
+						ConsumerConfigs(
+							ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+							ConsumerGroupIdConfig("my.group.id"),
+						),
+						ConsumerVReplicas(1),
+						ConsumerPlacement(kafkainternals.PodBind{PodName: "p2", PodNamespace: systemNamespace}),
+					)),
+				),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: func() runtime.Object {
+						cg := NewConsumerGroup(
+							ConsumerGroupConsumerSpec(NewConsumerSpec(
+								ConsumerTopics("t1", "t2"),
+								ConsumerConfigs(
+									ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+									ConsumerGroupIdConfig("my.group.id"),
+								),
+							)),
+							ConsumerGroupReplicas(2),
+							ConsumerGroupStatusReplicas(0),
+							ConsumerGroupOwnerRef(SourceAsOwnerReference()),
+							ConsumerGroupStatusSelector(ConsumerLabels),
+						)
+						cg.Status.Topics = []sources.TopicStatus{
+							{
+								Name: "t1",
+								Partitions: []sources.PartitionStatus{
+									{Partition: 0, EarliestOffset: 0, LatestOffset: 10},
+									{Partition: 1, EarliestOffset: 5, LatestOffset: 20},
+								},
+							},
+							{Name: "t2"},
+						}
+						cg.Status.Placements = []eventingduckv1alpha1.Placement{
+							{PodName: "p1", VReplicas: 1},
+							{PodName: "p2", VReplicas: 1},
+						}
+						_ = cg.MarkReconcileConsumersFailed("PropagateSubscriberURI", ErrNoSubscriberURI)
+						cg.MarkScheduleSucceeded()
+						cg.MarkAutoscalerDisabled() // KEDA not installed
+						return cg
+					}(),
+				},
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+			PostConditions: []func(*testing.T, *TableRow){
+				func(t *testing.T, row *TableRow) {
+					deleted := row.OtherTestData[testDeletedTopicsOffsetsKey].(*[]string)
+					if diff := cmp.Diff([]string{}, *deleted); diff != "" {
+						t.Errorf("unexpected garbage collected topics (-want, +got) %s", diff)
+					}
+				},
+			},
+		},
+		{
+			Name: "Consumers for source, failed to get topics offsets",
+			Objects: []runtime.Object{
+				NewService(),
+				NewConsumerGroup(
+					ConsumerGroupConsumerSpec(NewConsumerSpec(
+						ConsumerTopics("t1", "t2"),
+						ConsumerConfigs(
+							ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+							ConsumerGroupIdConfig("my.group.id"),
+						),
+					)),
+					ConsumerGroupReplicas(2),
+					ConsumerGroupOwnerRef(SourceAsOwnerReference()),
+				),
+			},
+			Key: ConsumerGroupTestKey,
+			OtherTestData: map[string]interface{}{
+				testTopicsOffsetsKey: kafka.GetTopicsOffsetsFunc(func(_ context.Context, _ sarama.Client, topics []string) (map[string]map[int32]offset.PartitionOffsets, error) {
+					return nil, sarama.ErrOutOfBrokers
+				}),
+				testSchedulerKey: SchedulerFunc(func(_ context.Context, vpod scheduler.VPod) ([]eventingduckv1alpha1.Placement, error) {
+					return []eventingduckv1alpha1.Placement{
+						{PodName: "p1", VReplicas: 1},
+						{PodName: "p2", VReplicas: 1},
+					}, nil
+				}),
+			},
+			WantCreates: []runtime.Object{
+				NewConsumer(1,
+					ConsumerFinalizer(),
+					ConsumerSpec(NewConsumerSpec(
+						ConsumerTopics("t1", "t2"),
+						ConsumerConfigs(
+							ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+							ConsumerGroupIdConfig("my.group.id"),
+						),
+						ConsumerVReplicas(1),
+						ConsumerPlacement(kafkainternals.PodBind{PodName: "p1", PodNamespace: systemNamespace}),
+					)),
+				),
+				NewConsumer(2,
+					ConsumerFinalizer(),
+					ConsumerSpec(NewConsumerSpec(
+						ConsumerTopics("t1", "t2"),
+						ConsumerConfigs(
+							ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+							ConsumerGroupIdConfig("my.group.id"),
+						),
+						ConsumerVReplicas(1),
+						ConsumerPlacement(kafkainternals.PodBind{PodName: "p2", PodNamespace: systemNamespace}),
+					)),
+				),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: func() runtime.Object {
+						cg := NewConsumerGroup(
+							ConsumerGroupConsumerSpec(NewConsumerSpec(
+								ConsumerTopics("t1", "t2"),
+								ConsumerConfigs(
+									ConsumerBootstrapServersConfig(ChannelBootstrapServers),
+									ConsumerGroupIdConfig("my.group.id"),
+								),
+							)),
+							ConsumerGroupReplicas(2),
+							ConsumerGroupStatusReplicas(0),
+							ConsumerGroupOwnerRef(SourceAsOwnerReference()),
 							ConsumerGroupStatusSelector(ConsumerLabels),
 						)
 						cg.Status.Placements = []eventingduckv1alpha1.Placement{
@@ -1700,13 +2577,41 @@ func TestReconcileKind(t *testing.T) {
 				}, nil
 			},
 			InitOffsetsFunc: func(ctx context.Context, kafkaClient sarama.Client, kafkaAdminClient sarama.ClusterAdmin, topics []string, consumerGroup string) (int32, error) {
+				if f, ok := row.OtherTestData[testInitOffsetsKey]; ok {
+					return f.(kafka.InitOffsetsFunc)(ctx, kafkaClient, kafkaAdminClient, topics, consumerGroup)
+				}
 				return 1, nil
 			},
 			SystemNamespace:                    systemNamespace,
 			AutoscalerConfig:                   "",
 			DeleteConsumerGroupMetadataCounter: counter.NewExpiringCounter(ctx),
 			InitOffsetLatestInitialOffsetCache: prober.NewLocalExpiringCache[string, prober.Status, struct{}](ctx, time.Second),
-			EnqueueKey:                         func(key string) {},
+			GetTopicsOffsetsFunc: func(ctx context.Context, kafkaClient sarama.Client, topics []string) (map[string]map[int32]offset.PartitionOffsets, error) {
+				if f, ok := row.OtherTestData[testTopicsOffsetsKey]; ok {
+					return f.(kafka.GetTopicsOffsetsFunc)(ctx, kafkaClient, topics)
+				}
+				return nil, nil
+			},
+			TopicsOffsetsCache: prober.NewLocalExpiringCache[string, prober.Status, struct{}](ctx, time.Second),
+			GetDeletedTopicsFunc: func(ctx context.Context, kafkaAdminClient sarama.ClusterAdmin, topics []string, consumerGroup string) ([]string, error) {
+				if f, ok := row.OtherTestData[testDeletedTopicsKey]; ok {
+					return f.(kafka.GetDeletedTopicsFunc)(ctx, kafkaAdminClient, topics, consumerGroup)
+				}
+				return nil, nil
+			},
+			DeleteTopicsOffsetsFunc: func(ctx context.Context, kafkaAdminClient sarama.ClusterAdmin, topics []string, consumerGroup string) error {
+				if err, ok := row.OtherTestData[testDeleteTopicsOffsetsErrorKey]; ok {
+					return err.(error)
+				}
+				if deleted, ok := row.OtherTestData[testDeletedTopicsOffsetsKey]; ok {
+					*deleted.(*[]string) = append(*deleted.(*[]string), topics...)
+				}
+				return nil
+			},
+			DeletedTopicsCache:        prober.NewLocalExpiringCache[string, prober.Status, struct{}](ctx, time.Second),
+			PendingDeletedTopicsCache: prober.NewLocalExpiringCache[string, prober.Status, struct{}](ctx, time.Second),
+			DeletedTopicsTracker:      newTestDeletedTopicsTracker(row),
+			EnqueueKey:                func(key string) {},
 		}
 
 		r.KafkaFeatureFlags = configapis.FromContext(store.ToContext(ctx))
@@ -1853,7 +2758,20 @@ func TestReconcileKindNoAutoscaler(t *testing.T) {
 			SystemNamespace:                    systemNamespace,
 			DeleteConsumerGroupMetadataCounter: counter.NewExpiringCounter(ctx),
 			InitOffsetLatestInitialOffsetCache: prober.NewLocalExpiringCache[string, prober.Status, struct{}](ctx, time.Second),
-			EnqueueKey:                         func(key string) {},
+			GetTopicsOffsetsFunc: func(ctx context.Context, kafkaClient sarama.Client, topics []string) (map[string]map[int32]offset.PartitionOffsets, error) {
+				return nil, nil
+			},
+			TopicsOffsetsCache: prober.NewLocalExpiringCache[string, prober.Status, struct{}](ctx, time.Second),
+			GetDeletedTopicsFunc: func(ctx context.Context, kafkaAdminClient sarama.ClusterAdmin, topics []string, consumerGroup string) ([]string, error) {
+				return nil, nil
+			},
+			DeleteTopicsOffsetsFunc: func(ctx context.Context, kafkaAdminClient sarama.ClusterAdmin, topics []string, consumerGroup string) error {
+				return nil
+			},
+			DeletedTopicsCache:        prober.NewLocalExpiringCache[string, prober.Status, struct{}](ctx, time.Second),
+			PendingDeletedTopicsCache: prober.NewLocalExpiringCache[string, prober.Status, struct{}](ctx, time.Second),
+			DeletedTopicsTracker:      NewDeletedTopicsTracker(3),
+			EnqueueKey:                func(key string) {},
 		}
 
 		r.KafkaFeatureFlags = configapis.DefaultFeaturesConfig()
@@ -2341,6 +3259,10 @@ func TestFinalizeKind(t *testing.T) {
 			KafkaFeatureFlags:                  configapis.DefaultFeaturesConfig(),
 			DeleteConsumerGroupMetadataCounter: counter.NewExpiringCounter(ctx),
 			InitOffsetLatestInitialOffsetCache: prober.NewLocalExpiringCache[string, prober.Status, struct{}](ctx, time.Second),
+			TopicsOffsetsCache:                 prober.NewLocalExpiringCache[string, prober.Status, struct{}](ctx, time.Second),
+			DeletedTopicsCache:                 prober.NewLocalExpiringCache[string, prober.Status, struct{}](ctx, time.Second),
+			PendingDeletedTopicsCache:          prober.NewLocalExpiringCache[string, prober.Status, struct{}](ctx, time.Second),
+			DeletedTopicsTracker:               NewDeletedTopicsTracker(3),
 		}
 
 		return consumergroup.NewReconciler(
@@ -2371,3 +3293,15 @@ func patchFinalizers() clientgotesting.PatchActionImpl {
 	action.Patch = []byte(patch)
 	return action
 }
+
+// newTestDeletedTopicsTracker returns a tracker that has already recorded the checks of the
+// testDeletedTopicsObservationsKey test data.
+func newTestDeletedTopicsTracker(row *TableRow) *DeletedTopicsTracker {
+	tracker := NewDeletedTopicsTracker(3)
+	if observations, ok := row.OtherTestData[testDeletedTopicsObservationsKey]; ok {
+		for _, deleted := range observations.([][]string) {
+			tracker.Observe(ConsumerGroupTestKey, deleted)
+		}
+	}
+	return tracker
+}
@@ -139,6 +139,13 @@ func NewController(ctx context.Context, watcher configmap.Watcher) *controller.I
 		AutoscalerConfig:                   env.AutoscalerConfigMap,
 		DeleteConsumerGroupMetadataCounter: counter.NewExpiringCounter(ctx),
 		InitOffsetLatestInitialOffsetCache: prober.NewLocalExpiringCache[string, prober.Status, struct{}](ctx, 20*time.Minute),
+		GetTopicsOffsetsFunc:               offset.GetTopicsOffsets,
+		TopicsOffsetsCache:                 prober.NewLocalExpiringCache[string, prober.Status, struct{}](ctx, 5*time.Minute),
+		GetDeletedTopicsFunc:               offset.GetDeletedTopics,
+		DeleteTopicsOffsetsFunc:            offset.DeleteTopicsOffsets,
+		DeletedTopicsCache:                 prober.NewLocalExpiringCache[string, prober.Status, struct{}](ctx, time.Hour),
+		PendingDeletedTopicsCache:          prober.NewLocalExpiringCache[string, prober.Status, struct{}](ctx, 2*time.Minute),
+		DeletedTopicsTracker:               NewDeletedTopicsTracker(3), // 3 checks spanning at least 4 minutes
 	}
 
 	clientPool := clientpool.Get(ctx)
@@ -195,6 +202,9 @@ func NewController(ctx context.Context, watcher configmap.Watcher) *controller.I
 			impl.Enqueue(obj)
 			if cg, ok := obj.(metav1.Object); ok && cg != nil {
 				r.InitOffsetLatestInitialOffsetCache.Expire(keyOf(cg))
+				r.TopicsOffsetsCache.Expire(keyOf(cg))
+				r.DeletedTopicsCache.Expire(keyOf(cg))
+				r.DeletedTopicsTracker.Forget(keyOf(cg))
 			}
 		},
 	})
@@ -0,0 +1,94 @@
+/*
+ * Copyright 2024 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumergroup
+
+import (
+	"sort"
+	"sync"
+)
+
+// DeletedTopicsTracker counts, for each consumer group, the consecutive checks in which a topic has been
+// reported as deleted by the Kafka cluster.
+//
+// A topic missing from a single metadata response might only be the result of a transient metadata gap,
+// for example during a broker restart, so a topic is confirmed as deleted only once it has been reported
+// as deleted by a given number of consecutive checks.
+type DeletedTopicsTracker struct {
+	threshold int
+
+	lock sync.Mutex
+	// observations maps a consumer group key to the number of consecutive checks in which each topic
+	// has been reported as deleted.
+	observations map[string]map[string]int
+}
+
+func NewDeletedTopicsTracker(threshold int) *DeletedTopicsTracker {
+	return &DeletedTopicsTracker{
+		threshold:    threshold,
+		observations: make(map[string]map[string]int),
+	}
+}
+
+// Observe records the topics reported as deleted by a check for the given consumer group and returns the
+// topics that have been reported as deleted by enough consecutive checks.
+//
+// Topics not reported as deleted by this check start again from zero.
+func (t *DeletedTopicsTracker) Observe(key string, deleted []string) []string {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	previous := t.observations[key]
+	current := make(map[string]int, len(deleted))
+	var confirmed []string
+	for _, topic := range deleted {
+		current[topic] = previous[topic] + 1
+		if current[topic] >= t.threshold {
+			confirmed = append(confirmed, topic)
+		}
+	}
+
+	if len(current) == 0 {
+		delete(t.observations, key)
+	} else {
+		t.observations[key] = current
+	}
+
+	sort.Strings(confirmed)
+	return confirmed
+}
+
+// HasPending returns true when some topics have been reported as deleted for the given consumer group, but not
+// by enough consecutive checks yet.
+func (t *DeletedTopicsTracker) HasPending(key string) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for _, count := range t.observations[key] {
+		if count < t.threshold {
+			return true
+		}
+	}
+	return false
+}
+
+// Forget removes every observation for the given consumer group.
+func (t *DeletedTopicsTracker) Forget(key string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.observations, key)
+}
@@ -0,0 +1,140 @@
+/*
+ * Copyright 2024 The Knative Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumergroup
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDeletedTopicsTracker(t *testing.T) {
+	tests := []struct {
+		name   string
+		checks [][]string
+		want   []string
+	}{
+		{
+			name:   "no deleted topics",
+			checks: [][]string{nil, nil, nil},
+		},
+		{
+			name:   "deleted topic, not enough checks",
+			checks: [][]string{{"t1"}, {"t1"}},
+		},
+		{
+			name:   "deleted topic, confirmed",
+			checks: [][]string{{"t1"}, {"t1"}, {"t1"}},
+			want:   []string{"t1"},
+		},
+		{
+			name:   "deleted topics, confirmed after different number of checks",
+			checks: [][]string{{"t2"}, {"t1", "t2"}, {"t1", "t2"}, {"t1", "t2"}},
+			want:   []string{"t1", "t2"},
+		},
+		{
+			name:   "transient absence",
+			checks: [][]string{{"t1"}, {"t1"}, nil, {"t1"}},
+		},
+		{
+			name:   "transient absence, then confirmed",
+			checks: [][]string{{"t1"}, {"t1"}, nil, {"t1"}, {"t1"}, {"t1"}},
+			want:   []string{"t1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := NewDeletedTopicsTracker(3)
+
+			var got []string
+			for _, deleted := range tt.checks {
+				got = tracker.Observe("ns/name", deleted)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Observe() (-want, +got) %s", diff)
+			}
+		})
+	}
+}
+
+func TestDeletedTopicsTrackerIsolatesConsumerGroups(t *testing.T) {
+	tracker := NewDeletedTopicsTracker(2)
+
+	tracker.Observe("ns/name1", []string{"t1"})
+	if got := tracker.Observe("ns/name2", []string{"t1"}); len(got) != 0 {
+		t.Errorf("Observe() for a different consumer group confirmed %v", got)
+	}
+	if diff := cmp.Diff([]string{"t1"}, tracker.Observe("ns/name1", []string{"t1"})); diff != "" {
+		t.Errorf("Observe() (-want, +got) %s", diff)
+	}
+}
+
+func TestDeletedTopicsTrackerForget(t *testing.T) {
+	tracker := NewDeletedTopicsTracker(2)
+
+	tracker.Observe("ns/name", []string{"t1"})
+	tracker.Forget("ns/name")
+	if got := tracker.Observe("ns/name", []string{"t1"}); len(got) != 0 {
+		t.Errorf("Observe() after Forget() confirmed %v", got)
+	}
+}
+
+func TestDeletedTopicsTrackerHasPending(t *testing.T) {
+	tests := []struct {
+		name   string
+		checks [][]string
+		want   bool
+	}{
+		{
+			name: "no checks",
+		},
+		{
+			name:   "no deleted topics",
+			checks: [][]string{nil, nil},
+		},
+		{
+			name:   "deleted topic, not enough checks",
+			checks: [][]string{{"t1"}, {"t1"}},
+			want:   true,
+		},
+		{
+			name:   "deleted topic, confirmed",
+			checks: [][]string{{"t1"}, {"t1"}, {"t1"}},
+		},
+		{
+			name:   "deleted topic confirmed, another deleted topic not enough checks",
+			checks: [][]string{{"t1"}, {"t1"}, {"t1", "t2"}},
+			want:   true,
+		},
+		{
+			name:   "transient absence",
+			checks: [][]string{{"t1"}, nil},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := NewDeletedTopicsTracker(3)
+
+			for _, deleted := range tt.checks {
+				tracker.Observe("ns/name", deleted)
+			}
+			if got := tracker.HasPending("ns/name"); got != tt.want {
+				t.Errorf("HasPending() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -52,6 +52,10 @@ const (
 	DefaultDeliveryOrder = sources.Ordered
 
 	KafkaConditionConsumerGroup apis.ConditionType = "ConsumerGroup" //condition is registered by controller
+
+	// KafkaConditionTopicsAvailable is False when some of the topics consumed by the KafkaSource have been
+	// deleted from the Kafka cluster, it doesn't affect the readiness of the KafkaSource.
+	KafkaConditionTopicsAvailable apis.ConditionType = "TopicsAvailable"
 )
 
 var (
@@ -151,6 +155,9 @@ func (r Reconciler) reconcileConsumerGroup(ctx context.Context, ks *sources.Kafk
 			Ordering: deliveryOrder,
 		}
 	}
+	if ks.Spec.RetryAttemptExtension != nil {
+		deliverySpec.RetryAttemptExtension = *ks.Spec.RetryAttemptExtension
+	}
 
 	expectedCg := &internalscg.ConsumerGroup{
 		ObjectMeta: metav1.ObjectMeta{
@@ -185,6 +192,7 @@ func (r Reconciler) reconcileConsumerGroup(ctx context.Context, ks *sources.Kafk
 					},
 					Delivery:   deliverySpec,
 					Subscriber: ks.Spec.Sink,
+					AuditSink:  ks.Spec.AuditSink,
 					Reply:      &internalscg.ReplyStrategy{NoReply: &internalscg.NoReply{Enabled: true}},
 				},
 			},
@@ -201,6 +209,11 @@ func (r Reconciler) reconcileConsumerGroup(ctx context.Context, ks *sources.Kafk
 		expectedCg.Spec.Template.Spec.Configs.KeyType = &kt
 	}
 
+	if ks.Spec.RebalanceProtocol != nil {
+		rp := string(*ks.Spec.RebalanceProtocol)
+		expectedCg.Spec.Template.Spec.Configs.RebalanceProtocol = &rp
+	}
+
 	if ks.Status.Auth != nil {
 		expectedCg.Spec.Template.Spec.OIDCServiceAccountName = ks.Status.Auth.ServiceAccountName
 	}
@@ -272,4 +285,27 @@ func propagateConsumerGroupStatus(cg *internalscg.ConsumerGroup, ks *sources.Kaf
 	if cg.Status.Replicas != nil {
 		ks.Status.Consumers = *cg.Status.Replicas
 	}
+	ks.Status.Topics = cg.Status.Topics
+	propagateDeletedTopics(ks)
+}
+
+// propagateDeletedTopics flags the KafkaSource when some of its topics have been deleted from the Kafka cluster,
+// since the offsets committed for those topics are garbage collected only once they're removed from the spec.
+func propagateDeletedTopics(ks *sources.KafkaSource) {
+	var deleted []string
+	for _, t := range ks.Status.Topics {
+		if t.Deleted {
+			deleted = append(deleted, t.Name)
+		}
+	}
+	if len(deleted) == 0 {
+		_ = ks.GetConditionSet().Manage(&ks.Status).ClearCondition(KafkaConditionTopicsAvailable)
+		return
+	}
+	ks.GetConditionSet().Manage(&ks.Status).MarkFalse(
+		KafkaConditionTopicsAvailable,
+		"TopicsDeleted",
+		"topics %s have been deleted from the Kafka cluster, remove them from the spec to garbage collect their offsets",
+		strings.Join(deleted, ", "),
+	)
 }
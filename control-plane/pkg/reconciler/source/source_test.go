@@ -129,6 +129,7 @@ func TestReconcileKind(t *testing.T) {
 						ConsumerConfigs(
 							ConsumerGroupIdConfig(SourceConsumerGroup),
 							ConsumerBootstrapServersConfig(SourceBootstrapServers),
+							ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
 						),
 						ConsumerAuth(NewConsumerSpecAuth()),
 						ConsumerDelivery(
@@ -183,6 +184,7 @@ func TestReconcileKind(t *testing.T) {
 						ConsumerConfigs(
 							ConsumerGroupIdConfig(SourceConsumerGroup),
 							ConsumerBootstrapServersConfig(SourceBootstrapServers),
+							ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
 						),
 						ConsumerAuth(NewConsumerSpecAuth()),
 						ConsumerDelivery(
@@ -238,6 +240,7 @@ func TestReconcileKind(t *testing.T) {
 						ConsumerConfigs(
 							ConsumerGroupIdConfig(SourceConsumerGroup),
 							ConsumerBootstrapServersConfig(SourceBootstrapServers),
+							ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
 						),
 						ConsumerAuth(NewConsumerSpecAuth()),
 						ConsumerDelivery(
@@ -296,6 +299,7 @@ func TestReconcileKind(t *testing.T) {
 						ConsumerConfigs(
 							ConsumerGroupIdConfig(SourceConsumerGroup),
 							ConsumerBootstrapServersConfig(SourceBootstrapServers),
+							ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
 						),
 						ConsumerAuth(NewConsumerSpecAuth()),
 						ConsumerDelivery(
@@ -353,6 +357,7 @@ func TestReconcileKind(t *testing.T) {
 						ConsumerConfigs(
 							ConsumerGroupIdConfig(SourceConsumerGroup),
 							ConsumerBootstrapServersConfig(SourceBootstrapServers),
+							ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
 							ConsumerKeyTypeConfig("int"),
 						),
 						ConsumerAuth(NewConsumerSpecAuth()),
@@ -390,6 +395,176 @@ func TestReconcileKind(t *testing.T) {
 				finalizerUpdatedEvent,
 			},
 		},
+		{
+			Name: "Reconciled normal, cooperative rebalance protocol",
+			Objects: []runtime.Object{
+				NewSource(WithRebalanceProtocol(sources.RebalanceProtocolCooperative)),
+			},
+			Key: testKey,
+			WantCreates: []runtime.Object{
+				NewConsumerGroup(
+					WithConsumerGroupFinalizer(),
+					WithConsumerGroupName(SourceUUID),
+					WithConsumerGroupNamespace(SourceNamespace),
+					WithConsumerGroupOwnerRef(kmeta.NewControllerRef(NewSource())),
+					WithConsumerGroupMetaLabels(OwnerAsSourceLabel),
+					WithConsumerGroupLabels(ConsumerSourceLabel),
+					ConsumerGroupConsumerSpec(NewConsumerSpec(
+						ConsumerTopics(SourceTopics[0], SourceTopics[1]),
+						ConsumerConfigs(
+							ConsumerGroupIdConfig(SourceConsumerGroup),
+							ConsumerBootstrapServersConfig(SourceBootstrapServers),
+							ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolCooperative)),
+						),
+						ConsumerAuth(NewConsumerSpecAuth()),
+						ConsumerDelivery(
+							NewConsumerSpecDelivery(
+								sources.Ordered,
+								NewConsumerTimeout("PT600S"),
+								NewConsumerRetry(10),
+								NewConsumerBackoffDelay("PT0.3S"),
+								NewConsumerBackoffPolicy(eventingduck.BackoffPolicyExponential),
+								ConsumerInitialOffset(sources.OffsetLatest),
+							),
+						),
+						ConsumerSubscriber(NewSourceSinkReference()),
+						ConsumerReply(ConsumerNoReply()),
+					)),
+					ConsumerGroupReplicas(1),
+				),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewSource(
+						WithRebalanceProtocol(sources.RebalanceProtocolCooperative),
+						StatusSourceConsumerGroupUnknown(),
+						StatusSourceSinkResolved(""),
+						StatusSourceSelector(),
+						StatusSourceOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+					),
+				},
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+		},
+		{
+			Name: "Reconciled normal, retry attempt extension",
+			Objects: []runtime.Object{
+				NewSource(WithRetryAttemptExtension("knativeerrorattempt")),
+			},
+			Key: testKey,
+			WantCreates: []runtime.Object{
+				NewConsumerGroup(
+					WithConsumerGroupFinalizer(),
+					WithConsumerGroupName(SourceUUID),
+					WithConsumerGroupNamespace(SourceNamespace),
+					WithConsumerGroupOwnerRef(kmeta.NewControllerRef(NewSource())),
+					WithConsumerGroupMetaLabels(OwnerAsSourceLabel),
+					WithConsumerGroupLabels(ConsumerSourceLabel),
+					ConsumerGroupConsumerSpec(NewConsumerSpec(
+						ConsumerTopics(SourceTopics[0], SourceTopics[1]),
+						ConsumerConfigs(
+							ConsumerGroupIdConfig(SourceConsumerGroup),
+							ConsumerBootstrapServersConfig(SourceBootstrapServers),
+							ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
+						),
+						ConsumerAuth(NewConsumerSpecAuth()),
+						ConsumerDelivery(
+							NewConsumerSpecDelivery(
+								sources.Ordered,
+								NewConsumerTimeout("PT600S"),
+								NewConsumerRetry(10),
+								NewConsumerBackoffDelay("PT0.3S"),
+								NewConsumerBackoffPolicy(eventingduck.BackoffPolicyExponential),
+								ConsumerInitialOffset(sources.OffsetLatest),
+								ConsumerRetryAttemptExtension("knativeerrorattempt"),
+							),
+						),
+						ConsumerSubscriber(NewSourceSinkReference()),
+						ConsumerReply(ConsumerNoReply()),
+					)),
+					ConsumerGroupReplicas(1),
+				),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewSource(
+						WithRetryAttemptExtension("knativeerrorattempt"),
+						StatusSourceConsumerGroupUnknown(),
+						StatusSourceSinkResolved(""),
+						StatusSourceSelector(),
+						StatusSourceOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+					),
+				},
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+		},
+		{
+			Name: "Reconciled normal, audit sink",
+			Objects: []runtime.Object{
+				NewSource(WithAuditSink(NewSourceAuditSink())),
+			},
+			Key: testKey,
+			WantCreates: []runtime.Object{
+				NewConsumerGroup(
+					WithConsumerGroupFinalizer(),
+					WithConsumerGroupName(SourceUUID),
+					WithConsumerGroupNamespace(SourceNamespace),
+					WithConsumerGroupOwnerRef(kmeta.NewControllerRef(NewSource())),
+					WithConsumerGroupMetaLabels(OwnerAsSourceLabel),
+					WithConsumerGroupLabels(ConsumerSourceLabel),
+					ConsumerGroupConsumerSpec(NewConsumerSpec(
+						ConsumerTopics(SourceTopics[0], SourceTopics[1]),
+						ConsumerConfigs(
+							ConsumerGroupIdConfig(SourceConsumerGroup),
+							ConsumerBootstrapServersConfig(SourceBootstrapServers),
+							ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
+						),
+						ConsumerAuth(NewConsumerSpecAuth()),
+						ConsumerDelivery(
+							NewConsumerSpecDelivery(
+								sources.Ordered,
+								NewConsumerTimeout("PT600S"),
+								NewConsumerRetry(10),
+								NewConsumerBackoffDelay("PT0.3S"),
+								NewConsumerBackoffPolicy(eventingduck.BackoffPolicyExponential),
+								ConsumerInitialOffset(sources.OffsetLatest),
+							),
+						),
+						ConsumerSubscriber(NewSourceSinkReference()),
+						ConsumerAuditSink(NewSourceAuditSink()),
+						ConsumerReply(ConsumerNoReply()),
+					)),
+					ConsumerGroupReplicas(1),
+				),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewSource(
+						WithAuditSink(NewSourceAuditSink()),
+						StatusSourceConsumerGroupUnknown(),
+						StatusSourceSinkResolved(""),
+						StatusSourceSelector(),
+						StatusSourceOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+					),
+				},
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+		},
 		{
 			Name: "Reconciled normal with SASL with type",
 			Objects: []runtime.Object{
@@ -458,6 +633,7 @@ func TestReconcileKind(t *testing.T) {
 						ConsumerConfigs(
 							ConsumerGroupIdConfig(SourceConsumerGroup),
 							ConsumerBootstrapServersConfig(SourceBootstrapServers),
+							ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
 						),
 						ConsumerAuth(&kafkainternals.Auth{
 							NetSpec: &bindings.KafkaNetSpec{
@@ -587,6 +763,7 @@ func TestReconcileKind(t *testing.T) {
 						ConsumerConfigs(
 							ConsumerGroupIdConfig(SourceConsumerGroup),
 							ConsumerBootstrapServersConfig(SourceBootstrapServers),
+							ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
 						),
 						ConsumerAuth(&kafkainternals.Auth{
 							NetSpec: &bindings.KafkaNetSpec{
@@ -670,6 +847,7 @@ func TestReconcileKind(t *testing.T) {
 						ConsumerConfigs(
 							ConsumerGroupIdConfig(SourceConsumerGroup),
 							ConsumerBootstrapServersConfig(SourceBootstrapServers),
+							ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
 						),
 						ConsumerAuth(NewConsumerSpecAuth()),
 						ConsumerDelivery(
@@ -731,6 +909,7 @@ func TestReconcileKind(t *testing.T) {
 						ConsumerConfigs(
 							ConsumerGroupIdConfig(SourceConsumerGroup),
 							ConsumerBootstrapServersConfig(SourceBootstrapServers),
+							ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
 						),
 						ConsumerAuth(NewConsumerSpecAuth()),
 						ConsumerDelivery(
@@ -785,6 +964,7 @@ func TestReconcileKind(t *testing.T) {
 						ConsumerConfigs(
 							ConsumerGroupIdConfig(SourceConsumerGroup),
 							ConsumerBootstrapServersConfig(SourceBootstrapServers),
+							ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
 						),
 						ConsumerAuth(NewConsumerSpecAuth()),
 						ConsumerDelivery(
@@ -818,6 +998,7 @@ func TestReconcileKind(t *testing.T) {
 							ConsumerConfigs(
 								ConsumerGroupIdConfig(SourceConsumerGroup),
 								ConsumerBootstrapServersConfig(SourceBootstrapServers),
+								ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
 							),
 							ConsumerAuth(NewConsumerSpecAuth()),
 							ConsumerDelivery(
@@ -885,6 +1066,7 @@ func TestReconcileKind(t *testing.T) {
 							ConsumerConfigs(
 								ConsumerGroupIdConfig(SourceConsumerGroup),
 								ConsumerBootstrapServersConfig(SourceBootstrapServers),
+								ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
 							),
 							ConsumerAuth(NewConsumerSpecAuth()),
 							ConsumerDelivery(
@@ -923,6 +1105,98 @@ func TestReconcileKind(t *testing.T) {
 				finalizerUpdatedEvent,
 			},
 		},
+		{
+			Name: "Reconciled normal - existing cg with rebalance protocol update",
+			Objects: []runtime.Object{
+				NewSource(
+					WithSourceConsumers(1),
+					WithRebalanceProtocol(sources.RebalanceProtocolCooperative),
+				),
+				NewConsumerGroup(
+					WithConsumerGroupName(SourceUUID),
+					WithConsumerGroupNamespace(SourceNamespace),
+					WithConsumerGroupOwnerRef(kmeta.NewControllerRef(NewSource())),
+					WithConsumerGroupMetaLabels(OwnerAsSourceLabel),
+					WithConsumerGroupLabels(ConsumerSourceLabel),
+					ConsumerGroupConsumerSpec(NewConsumerSpec(
+						ConsumerTopics(SourceTopics[0], SourceTopics[1]),
+						ConsumerConfigs(
+							ConsumerGroupIdConfig(SourceConsumerGroup),
+							ConsumerBootstrapServersConfig(SourceBootstrapServers),
+							ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
+						),
+						ConsumerAuth(NewConsumerSpecAuth()),
+						ConsumerDelivery(
+							NewConsumerSpecDelivery(
+								sources.Ordered,
+								NewConsumerTimeout("PT600S"),
+								NewConsumerRetry(10),
+								NewConsumerBackoffDelay("PT0.3S"),
+								NewConsumerBackoffPolicy(eventingduck.BackoffPolicyExponential),
+								ConsumerInitialOffset(sources.OffsetLatest),
+							),
+						),
+						ConsumerSubscriber(NewSourceSinkReference()),
+						ConsumerReply(ConsumerNoReply()),
+					)),
+					ConsumerGroupReplicas(1),
+					ConsumerGroupReady,
+				),
+			},
+			Key: testKey,
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewConsumerGroup(
+						WithConsumerGroupName(SourceUUID),
+						WithConsumerGroupNamespace(SourceNamespace),
+						WithConsumerGroupOwnerRef(kmeta.NewControllerRef(NewSource())),
+						WithConsumerGroupMetaLabels(OwnerAsSourceLabel),
+						WithConsumerGroupLabels(ConsumerSourceLabel),
+						ConsumerGroupConsumerSpec(NewConsumerSpec(
+							ConsumerTopics(SourceTopics[0], SourceTopics[1]),
+							ConsumerConfigs(
+								ConsumerGroupIdConfig(SourceConsumerGroup),
+								ConsumerBootstrapServersConfig(SourceBootstrapServers),
+								ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolCooperative)),
+							),
+							ConsumerAuth(NewConsumerSpecAuth()),
+							ConsumerDelivery(
+								NewConsumerSpecDelivery(
+									sources.Ordered,
+									NewConsumerTimeout("PT600S"),
+									NewConsumerRetry(10),
+									NewConsumerBackoffDelay("PT0.3S"),
+									NewConsumerBackoffPolicy(eventingduck.BackoffPolicyExponential),
+									ConsumerInitialOffset(sources.OffsetLatest),
+								),
+							),
+							ConsumerSubscriber(NewSourceSinkReference()),
+							ConsumerReply(ConsumerNoReply()),
+						)),
+						ConsumerGroupReady,
+						ConsumerGroupReplicas(1),
+					),
+				},
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewSource(
+						WithSourceConsumers(1),
+						WithRebalanceProtocol(sources.RebalanceProtocolCooperative),
+						StatusSourceConsumerGroup(),
+						StatusSourceSinkResolved(""),
+						StatusSourceSelector(),
+						StatusSourceOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+					),
+				},
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+		},
 		{
 			Name: "Reconciled normal - existing cg with annotations update",
 			Objects: []runtime.Object{
@@ -952,6 +1226,7 @@ func TestReconcileKind(t *testing.T) {
 							ConsumerConfigs(
 								ConsumerGroupIdConfig(SourceConsumerGroup),
 								ConsumerBootstrapServersConfig(SourceBootstrapServers),
+								ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
 							),
 							ConsumerAuth(NewConsumerSpecAuth()),
 							ConsumerDelivery(
@@ -1018,6 +1293,7 @@ func TestReconcileKind(t *testing.T) {
 							ConsumerConfigs(
 								ConsumerGroupIdConfig(SourceConsumerGroup),
 								ConsumerBootstrapServersConfig(SourceBootstrapServers),
+								ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
 							),
 							ConsumerAuth(NewConsumerSpecAuth()),
 							ConsumerDelivery(
@@ -1071,6 +1347,62 @@ func TestReconcileKind(t *testing.T) {
 						ConsumerConfigs(
 							ConsumerGroupIdConfig(SourceConsumerGroup),
 							ConsumerBootstrapServersConfig(SourceBootstrapServers),
+							ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
+						),
+						ConsumerAuth(NewConsumerSpecAuth()),
+						ConsumerDelivery(
+							NewConsumerSpecDelivery(
+								sources.Ordered,
+								NewConsumerTimeout("PT600S"),
+								NewConsumerRetry(10),
+								NewConsumerBackoffDelay("PT0.3S"),
+								NewConsumerBackoffPolicy(eventingduck.BackoffPolicyExponential),
+								ConsumerInitialOffset(sources.OffsetLatest),
+							),
+						),
+						ConsumerSubscriber(NewSourceSinkReference()),
+						ConsumerReply(ConsumerNoReply()),
+					)),
+					ConsumerGroupReplicas(1),
+					ConsumerGroupReady,
+				),
+			},
+			Key: testKey,
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewSource(
+						StatusSourceConsumerGroup(),
+						StatusSourceSinkResolved(""),
+						StatusSourceSelector(),
+						WithAutoscalingAnnotationsSource(),
+						StatusSourceOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+					),
+				},
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+		},
+		{
+			Name: "Reconciled normal - existing cg with topics status",
+			Objects: []runtime.Object{
+				NewSource(WithAutoscalingAnnotationsSource()),
+				NewConsumerGroup(
+					WithConsumerGroupName(SourceUUID),
+					WithConsumerGroupNamespace(SourceNamespace),
+					WithConsumerGroupOwnerRef(kmeta.NewControllerRef(NewSource())),
+					WithConsumerGroupMetaLabels(OwnerAsSourceLabel),
+					WithConsumerGroupLabels(ConsumerSourceLabel),
+					WithConsumerGroupAnnotations(ConsumerGroupAnnotations),
+					ConsumerGroupConsumerSpec(NewConsumerSpec(
+						ConsumerTopics(SourceTopics[0], SourceTopics[1]),
+						ConsumerConfigs(
+							ConsumerGroupIdConfig(SourceConsumerGroup),
+							ConsumerBootstrapServersConfig(SourceBootstrapServers),
+							ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
 						),
 						ConsumerAuth(NewConsumerSpecAuth()),
 						ConsumerDelivery(
@@ -1088,6 +1420,7 @@ func TestReconcileKind(t *testing.T) {
 					)),
 					ConsumerGroupReplicas(1),
 					ConsumerGroupReady,
+					ConsumerGroupStatusTopics(sourceTopicsStatus()...),
 				),
 			},
 			Key: testKey,
@@ -1099,6 +1432,68 @@ func TestReconcileKind(t *testing.T) {
 						StatusSourceSelector(),
 						WithAutoscalingAnnotationsSource(),
 						StatusSourceOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+						StatusSourceTopics(sourceTopicsStatus()...),
+					),
+				},
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(),
+			},
+			WantEvents: []string{
+				finalizerUpdatedEvent,
+			},
+		},
+		{
+			Name: "Reconciled normal - existing cg with deleted topic",
+			Objects: []runtime.Object{
+				NewSource(WithAutoscalingAnnotationsSource()),
+				NewConsumerGroup(
+					WithConsumerGroupName(SourceUUID),
+					WithConsumerGroupNamespace(SourceNamespace),
+					WithConsumerGroupOwnerRef(kmeta.NewControllerRef(NewSource())),
+					WithConsumerGroupMetaLabels(OwnerAsSourceLabel),
+					WithConsumerGroupLabels(ConsumerSourceLabel),
+					WithConsumerGroupAnnotations(ConsumerGroupAnnotations),
+					ConsumerGroupConsumerSpec(NewConsumerSpec(
+						ConsumerTopics(SourceTopics[0], SourceTopics[1]),
+						ConsumerConfigs(
+							ConsumerGroupIdConfig(SourceConsumerGroup),
+							ConsumerBootstrapServersConfig(SourceBootstrapServers),
+							ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
+						),
+						ConsumerAuth(NewConsumerSpecAuth()),
+						ConsumerDelivery(
+							NewConsumerSpecDelivery(
+								sources.Ordered,
+								NewConsumerTimeout("PT600S"),
+								NewConsumerRetry(10),
+								NewConsumerBackoffDelay("PT0.3S"),
+								NewConsumerBackoffPolicy(eventingduck.BackoffPolicyExponential),
+								ConsumerInitialOffset(sources.OffsetLatest),
+							),
+						),
+						ConsumerSubscriber(NewSourceSinkReference()),
+						ConsumerReply(ConsumerNoReply()),
+					)),
+					ConsumerGroupReplicas(1),
+					ConsumerGroupReady,
+					ConsumerGroupStatusTopics(sourceDeletedTopicsStatus()...),
+				),
+			},
+			Key: testKey,
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewSource(
+						StatusSourceConsumerGroup(),
+						StatusSourceSinkResolved(""),
+						StatusSourceSelector(),
+						WithAutoscalingAnnotationsSource(),
+						StatusSourceOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+						StatusSourceTopics(sourceDeletedTopicsStatus()...),
+						StatusSourceTopicsUnavailable(
+							"TopicsDeleted",
+							fmt.Sprintf("topics %s have been deleted from the Kafka cluster, remove them from the spec to garbage collect their offsets", SourceTopics[1]),
+						),
 					),
 				},
 			},
@@ -1124,6 +1519,7 @@ func TestReconcileKind(t *testing.T) {
 						ConsumerConfigs(
 							ConsumerGroupIdConfig(SourceConsumerGroup),
 							ConsumerBootstrapServersConfig(SourceBootstrapServers),
+							ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
 						),
 						ConsumerAuth(NewConsumerSpecAuth()),
 						ConsumerDelivery(
@@ -1176,6 +1572,7 @@ func TestReconcileKind(t *testing.T) {
 						ConsumerConfigs(
 							ConsumerGroupIdConfig(SourceConsumerGroup),
 							ConsumerBootstrapServersConfig(SourceBootstrapServers),
+							ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
 						),
 						ConsumerAuth(NewConsumerSpecAuth()),
 						ConsumerDelivery(
@@ -1229,6 +1626,7 @@ func TestReconcileKind(t *testing.T) {
 						ConsumerConfigs(
 							ConsumerGroupIdConfig(SourceConsumerGroup),
 							ConsumerBootstrapServersConfig(SourceBootstrapServers),
+							ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
 						),
 						ConsumerAuth(NewConsumerSpecAuth()),
 						ConsumerDelivery(
@@ -1284,6 +1682,7 @@ func TestReconcileKind(t *testing.T) {
 						ConsumerConfigs(
 							ConsumerGroupIdConfig(SourceConsumerGroup),
 							ConsumerBootstrapServersConfig(SourceBootstrapServers),
+							ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
 						),
 						ConsumerAuth(NewConsumerSpecAuth()),
 						ConsumerDelivery(
@@ -1318,6 +1717,7 @@ func TestReconcileKind(t *testing.T) {
 							ConsumerConfigs(
 								ConsumerGroupIdConfig(SourceConsumerGroup),
 								ConsumerBootstrapServersConfig(SourceBootstrapServers),
+								ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
 							),
 							ConsumerAuth(NewConsumerSpecAuth()),
 							ConsumerDelivery(
@@ -1373,6 +1773,7 @@ func TestReconcileKind(t *testing.T) {
 						ConsumerConfigs(
 							ConsumerGroupIdConfig(SourceConsumerGroup),
 							ConsumerBootstrapServersConfig(SourceBootstrapServers),
+							ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
 						),
 						ConsumerAuth(NewConsumerSpecAuth()),
 						ConsumerDelivery(
@@ -1438,6 +1839,7 @@ func TestReconcileKind(t *testing.T) {
 						ConsumerConfigs(
 							ConsumerGroupIdConfig(SourceConsumerGroup),
 							ConsumerBootstrapServersConfig(SourceBootstrapServers),
+							ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
 						),
 						ConsumerAuth(NewConsumerSpecAuth()),
 						ConsumerDelivery(
@@ -1493,6 +1895,7 @@ func TestReconcileKind(t *testing.T) {
 						ConsumerConfigs(
 							ConsumerGroupIdConfig(SourceConsumerGroup),
 							ConsumerBootstrapServersConfig(SourceBootstrapServers),
+							ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
 						),
 						ConsumerAuth(NewConsumerSpecAuth()),
 						ConsumerDelivery(
@@ -1552,6 +1955,7 @@ func TestReconcileKind(t *testing.T) {
 						ConsumerConfigs(
 							ConsumerGroupIdConfig(SourceConsumerGroup),
 							ConsumerBootstrapServersConfig(SourceBootstrapServers),
+							ConsumerRebalanceProtocolConfig(string(sources.RebalanceProtocolEager)),
 						),
 						ConsumerAuth(NewConsumerSpecAuth()),
 						ConsumerDelivery(
@@ -1645,6 +2049,13 @@ func StatusSourceConsumerGroup() KRShapedOption {
 	}
 }
 
+func StatusSourceTopicsUnavailable(reason string, msg string) KRShapedOption {
+	return func(obj duckv1.KRShaped) {
+		ks := obj.(*sources.KafkaSource)
+		ks.GetConditionSet().Manage(ks.GetStatus()).MarkFalse(KafkaConditionTopicsAvailable, reason, msg)
+	}
+}
+
 func StatusSourceConsumerGroupFailed(reason string, msg string) KRShapedOption {
 	return func(obj duckv1.KRShaped) {
 		ks := obj.(*sources.KafkaSource)
@@ -1730,3 +2141,25 @@ func makeKafkaSourceOIDCServiceAccount() *corev1.ServiceAccount {
 		UID:       SourceUUID,
 	})
 }
+
+func sourceTopicsStatus() []sources.TopicStatus {
+	return []sources.TopicStatus{
+		{
+			Name: SourceTopics[0],
+			Partitions: []sources.PartitionStatus{
+				{Partition: 0, EarliestOffset: 0, LatestOffset: 42},
+				{Partition: 1, EarliestOffset: 10, LatestOffset: 35},
+			},
+		},
+		{
+			// Topic ACLs don't allow describing the topic.
+			Name: SourceTopics[1],
+		},
+	}
+}
+
+func sourceDeletedTopicsStatus() []sources.TopicStatus {
+	topics := sourceTopicsStatus()
+	topics[1].Deleted = true
+	return topics
+}
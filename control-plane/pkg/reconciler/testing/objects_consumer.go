@@ -235,6 +235,12 @@ func ConsumerInitialOffset(offset sources.Offset) DeliverySpecOption {
 	}
 }
 
+func ConsumerRetryAttemptExtension(extension string) DeliverySpecOption {
+	return func(spec *kafkainternals.DeliverySpec) {
+		spec.RetryAttemptExtension = extension
+	}
+}
+
 func ConsumerBootstrapServersConfig(s string) ConsumerConfigsOption {
 	return func(configs *kafkainternals.ConsumerConfigs) {
 		configs.Configs["bootstrap.servers"] = s
@@ -253,6 +259,12 @@ func ConsumerKeyTypeConfig(s string) ConsumerConfigsOption {
 	}
 }
 
+func ConsumerRebalanceProtocolConfig(s string) ConsumerConfigsOption {
+	return func(configs *kafkainternals.ConsumerConfigs) {
+		configs.RebalanceProtocol = &s
+	}
+}
+
 func ConsumerVReplicas(vreplicas int32) ConsumerSpecOption {
 	return func(c *kafkainternals.ConsumerSpec) {
 		c.VReplicas = &vreplicas
@@ -277,6 +289,12 @@ func ConsumerSubscriber(dest duckv1.Destination) ConsumerSpecOption {
 	}
 }
 
+func ConsumerAuditSink(dest duckv1.Destination) ConsumerSpecOption {
+	return func(c *kafkainternals.ConsumerSpec) {
+		c.AuditSink = &dest
+	}
+}
+
 func ConsumerCloudEventOverrides(ce *duckv1.CloudEventOverrides) ConsumerSpecOption {
 	return func(c *kafkainternals.ConsumerSpec) {
 		c.CloudEventOverrides = ce
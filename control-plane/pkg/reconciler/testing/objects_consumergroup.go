@@ -29,6 +29,7 @@ import (
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
 	kafkainternals "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/internalskafkaeventing/v1alpha1"
+	sources "knative.dev/eventing-kafka-broker/control-plane/pkg/apis/sources/v1beta1"
 	"knative.dev/eventing-kafka-broker/control-plane/pkg/autoscaler"
 	"knative.dev/eventing-kafka-broker/control-plane/pkg/autoscaler/keda"
 )
@@ -196,6 +197,12 @@ func ConsumerGroupStatusSelector(label map[string]string) ConsumerGroupOption {
 	}
 }
 
+func ConsumerGroupStatusTopics(topics ...sources.TopicStatus) ConsumerGroupOption {
+	return func(cg *kafkainternals.ConsumerGroup) {
+		cg.Status.Topics = topics
+	}
+}
+
 func ConsumerGroupReplicasStatus(replicas int32) ConsumerGroupOption {
 	return func(cg *kafkainternals.ConsumerGroup) {
 		cg.Status.Replicas = pointer.Int32(replicas)
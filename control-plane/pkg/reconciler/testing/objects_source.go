@@ -152,6 +152,23 @@ func NewSourceSinkReference() duckv1.Destination {
 	}
 }
 
+func NewSourceAuditSink() duckv1.Destination {
+	return duckv1.Destination{
+		URI: apis.HTTP("audit.example.com"),
+	}
+}
+
+func NewSourceAuditSinkReferenceWithoutNamespace() duckv1.Destination {
+	s := NewService2()
+	return duckv1.Destination{
+		Ref: &duckv1.KReference{
+			Kind:       s.Kind,
+			Name:       s.Name,
+			APIVersion: s.APIVersion,
+		},
+	}
+}
+
 func NewSourceSinkReferenceWithCACert() duckv1.Destination {
 	s := NewService()
 	return duckv1.Destination{
@@ -205,6 +222,34 @@ func WithOrdering(ordering sources.DeliveryOrdering) KRShapedOption {
 	}
 }
 
+func WithRebalanceProtocol(protocol sources.RebalanceProtocol) KRShapedOption {
+	return func(obj duckv1.KRShaped) {
+		s := obj.(*sources.KafkaSource)
+		s.Spec.RebalanceProtocol = &protocol
+	}
+}
+
+func WithRetryAttemptExtension(extension string) KRShapedOption {
+	return func(obj duckv1.KRShaped) {
+		s := obj.(*sources.KafkaSource)
+		s.Spec.RetryAttemptExtension = &extension
+	}
+}
+
+func WithAuditSink(dest duckv1.Destination) KRShapedOption {
+	return func(obj duckv1.KRShaped) {
+		s := obj.(*sources.KafkaSource)
+		s.Spec.AuditSink = &dest
+	}
+}
+
+func StatusSourceTopics(topics ...sources.TopicStatus) KRShapedOption {
+	return func(obj duckv1.KRShaped) {
+		s := obj.(*sources.KafkaSource)
+		s.Status.Topics = topics
+	}
+}
+
 func SourceDispatcherPod(namespace string, annotations map[string]string) runtime.Object {
 	return &corev1.Pod{
 		TypeMeta: metav1.TypeMeta{
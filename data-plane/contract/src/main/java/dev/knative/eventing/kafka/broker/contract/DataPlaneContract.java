@@ -370,6 +370,149 @@ public final class DataPlaneContract {
         // @@protoc_insertion_point(enum_scope:KeyType)
     }
 
+    /**
+     * <pre>
+     * Consumer group rebalance protocol
+     * </pre>
+     *
+     * Protobuf enum {@code RebalanceProtocol}
+     */
+    public enum RebalanceProtocol implements com.google.protobuf.ProtocolMessageEnum {
+        /**
+         * <pre>
+         * The configured assignors are used as they are.
+         * </pre>
+         *
+         * <code>UNSPECIFIED = 0;</code>
+         */
+        UNSPECIFIED(0),
+        /**
+         * <pre>
+         * Stop-the-world rebalance, all partitions are revoked before being reassigned.
+         * </pre>
+         *
+         * <code>EAGER = 1;</code>
+         */
+        EAGER(1),
+        /**
+         * <pre>
+         * Incremental rebalance, only partitions moving to another consumer are revoked.
+         * </pre>
+         *
+         * <code>COOPERATIVE = 2;</code>
+         */
+        COOPERATIVE(2),
+        UNRECOGNIZED(-1),
+        ;
+
+        /**
+         * <pre>
+         * The configured assignors are used as they are.
+         * </pre>
+         *
+         * <code>UNSPECIFIED = 0;</code>
+         */
+        public static final int UNSPECIFIED_VALUE = 0;
+        /**
+         * <pre>
+         * Stop-the-world rebalance, all partitions are revoked before being reassigned.
+         * </pre>
+         *
+         * <code>EAGER = 1;</code>
+         */
+        public static final int EAGER_VALUE = 1;
+        /**
+         * <pre>
+         * Incremental rebalance, only partitions moving to another consumer are revoked.
+         * </pre>
+         *
+         * <code>COOPERATIVE = 2;</code>
+         */
+        public static final int COOPERATIVE_VALUE = 2;
+
+        public final int getNumber() {
+            if (this == UNRECOGNIZED) {
+                throw new java.lang.IllegalArgumentException("Can't get the number of an unknown enum value.");
+            }
+            return value;
+        }
+
+        /**
+         * @param value The numeric wire value of the corresponding enum entry.
+         * @return The enum associated with the given numeric wire value.
+         * @deprecated Use {@link #forNumber(int)} instead.
+         */
+        @java.lang.Deprecated
+        public static RebalanceProtocol valueOf(int value) {
+            return forNumber(value);
+        }
+
+        /**
+         * @param value The numeric wire value of the corresponding enum entry.
+         * @return The enum associated with the given numeric wire value.
+         */
+        public static RebalanceProtocol forNumber(int value) {
+            switch (value) {
+                case 0:
+                    return UNSPECIFIED;
+                case 1:
+                    return EAGER;
+                case 2:
+                    return COOPERATIVE;
+                default:
+                    return null;
+            }
+        }
+
+        public static com.google.protobuf.Internal.EnumLiteMap<RebalanceProtocol> internalGetValueMap() {
+            return internalValueMap;
+        }
+
+        private static final com.google.protobuf.Internal.EnumLiteMap<RebalanceProtocol> internalValueMap =
+                new com.google.protobuf.Internal.EnumLiteMap<RebalanceProtocol>() {
+                    public RebalanceProtocol findValueByNumber(int number) {
+                        return RebalanceProtocol.forNumber(number);
+                    }
+                };
+
+        public final com.google.protobuf.Descriptors.EnumValueDescriptor getValueDescriptor() {
+            if (this == UNRECOGNIZED) {
+                throw new java.lang.IllegalStateException("Can't get the descriptor of an unrecognized enum value.");
+            }
+            return getDescriptor().getValues().get(ordinal());
+        }
+
+        public final com.google.protobuf.Descriptors.EnumDescriptor getDescriptorForType() {
+            return getDescriptor();
+        }
+
+        public static final com.google.protobuf.Descriptors.EnumDescriptor getDescriptor() {
+            return dev.knative.eventing.kafka.broker.contract.DataPlaneContract.getDescriptor()
+                    .getEnumTypes()
+                    .get(3);
+        }
+
+        private static final RebalanceProtocol[] VALUES = values();
+
+        public static RebalanceProtocol valueOf(com.google.protobuf.Descriptors.EnumValueDescriptor desc) {
+            if (desc.getType() != getDescriptor()) {
+                throw new java.lang.IllegalArgumentException("EnumValueDescriptor is not for this type.");
+            }
+            if (desc.getIndex() == -1) {
+                return UNRECOGNIZED;
+            }
+            return VALUES[desc.getIndex()];
+        }
+
+        private final int value;
+
+        private RebalanceProtocol(int value) {
+            this.value = value;
+        }
+
+        // @@protoc_insertion_point(enum_scope:RebalanceProtocol)
+    }
+
     /**
      * <pre>
      * CloudEvent content mode
@@ -455,7 +598,7 @@ public final class DataPlaneContract {
         public static final com.google.protobuf.Descriptors.EnumDescriptor getDescriptor() {
             return dev.knative.eventing.kafka.broker.contract.DataPlaneContract.getDescriptor()
                     .getEnumTypes()
-                    .get(3);
+                    .get(4);
         }
 
         private static final ContentMode[] VALUES = values();
@@ -600,7 +743,7 @@ public final class DataPlaneContract {
         public static final com.google.protobuf.Descriptors.EnumDescriptor getDescriptor() {
             return dev.knative.eventing.kafka.broker.contract.DataPlaneContract.getDescriptor()
                     .getEnumTypes()
-                    .get(4);
+                    .get(5);
         }
 
         private static final SecretField[] VALUES = values();
@@ -725,7 +868,7 @@ public final class DataPlaneContract {
         public static final com.google.protobuf.Descriptors.EnumDescriptor getDescriptor() {
             return dev.knative.eventing.kafka.broker.contract.DataPlaneContract.getDescriptor()
                     .getEnumTypes()
-                    .get(5);
+                    .get(6);
         }
 
         private static final Protocol[] VALUES = values();
@@ -11725,6 +11868,30 @@ public final class DataPlaneContract {
          * @return The bytes for format.
          */
         com.google.protobuf.ByteString getFormatBytes();
+        /**
+         * <pre>
+         * retryAttemptExtension is the name of the CloudEvent extension set to the
+         * delivery attempt number of the event, starting at 1 and incremented on each retry.
+         *
+         * Setting retryAttemptExtension to empty means don't set the extension.
+         * </pre>
+         *
+         * <code>string retryAttemptExtension = 9;</code>
+         * @return The retryAttemptExtension.
+         */
+        java.lang.String getRetryAttemptExtension();
+        /**
+         * <pre>
+         * retryAttemptExtension is the name of the CloudEvent extension set to the
+         * delivery attempt number of the event, starting at 1 and incremented on each retry.
+         *
+         * Setting retryAttemptExtension to empty means don't set the extension.
+         * </pre>
+         *
+         * <code>string retryAttemptExtension = 9;</code>
+         * @return The bytes for retryAttemptExtension.
+         */
+        com.google.protobuf.ByteString getRetryAttemptExtensionBytes();
 
         /**
          * <pre>
@@ -11795,6 +11962,7 @@ public final class DataPlaneContract {
             deadLetterCACerts_ = "";
             deadLetterAudience_ = "";
             format_ = "";
+            retryAttemptExtension_ = "";
             backoffPolicy_ = 0;
         }
 
@@ -11868,6 +12036,12 @@ public final class DataPlaneContract {
                             format_ = s;
                             break;
                         }
+                        case 74: {
+                            java.lang.String s = input.readStringRequireUtf8();
+
+                            retryAttemptExtension_ = s;
+                            break;
+                        }
                         default: {
                             if (!parseUnknownField(input, unknownFields, extensionRegistry, tag)) {
                                 done = true;
@@ -12067,6 +12241,54 @@ public final class DataPlaneContract {
             }
         }
 
+        public static final int RETRYATTEMPTEXTENSION_FIELD_NUMBER = 9;
+        private volatile java.lang.Object retryAttemptExtension_;
+        /**
+         * <pre>
+         * retryAttemptExtension is the name of the CloudEvent extension set to the
+         * delivery attempt number of the event, starting at 1 and incremented on each retry.
+         *
+         * Setting retryAttemptExtension to empty means don't set the extension.
+         * </pre>
+         *
+         * <code>string retryAttemptExtension = 9;</code>
+         * @return The retryAttemptExtension.
+         */
+        @java.lang.Override
+        public java.lang.String getRetryAttemptExtension() {
+            java.lang.Object ref = retryAttemptExtension_;
+            if (ref instanceof java.lang.String) {
+                return (java.lang.String) ref;
+            } else {
+                com.google.protobuf.ByteString bs = (com.google.protobuf.ByteString) ref;
+                java.lang.String s = bs.toStringUtf8();
+                retryAttemptExtension_ = s;
+                return s;
+            }
+        }
+        /**
+         * <pre>
+         * retryAttemptExtension is the name of the CloudEvent extension set to the
+         * delivery attempt number of the event, starting at 1 and incremented on each retry.
+         *
+         * Setting retryAttemptExtension to empty means don't set the extension.
+         * </pre>
+         *
+         * <code>string retryAttemptExtension = 9;</code>
+         * @return The bytes for retryAttemptExtension.
+         */
+        @java.lang.Override
+        public com.google.protobuf.ByteString getRetryAttemptExtensionBytes() {
+            java.lang.Object ref = retryAttemptExtension_;
+            if (ref instanceof java.lang.String) {
+                com.google.protobuf.ByteString b = com.google.protobuf.ByteString.copyFromUtf8((java.lang.String) ref);
+                retryAttemptExtension_ = b;
+                return b;
+            } else {
+                return (com.google.protobuf.ByteString) ref;
+            }
+        }
+
         public static final int RETRY_FIELD_NUMBER = 2;
         private int retry_;
         /**
@@ -12186,6 +12408,9 @@ public final class DataPlaneContract {
             if (!getFormatBytes().isEmpty()) {
                 com.google.protobuf.GeneratedMessageV3.writeString(output, 8, format_);
             }
+            if (!getRetryAttemptExtensionBytes().isEmpty()) {
+                com.google.protobuf.GeneratedMessageV3.writeString(output, 9, retryAttemptExtension_);
+            }
             unknownFields.writeTo(output);
         }
 
@@ -12221,6 +12446,9 @@ public final class DataPlaneContract {
             if (!getFormatBytes().isEmpty()) {
                 size += com.google.protobuf.GeneratedMessageV3.computeStringSize(8, format_);
             }
+            if (!getRetryAttemptExtensionBytes().isEmpty()) {
+                size += com.google.protobuf.GeneratedMessageV3.computeStringSize(9, retryAttemptExtension_);
+            }
             size += unknownFields.getSerializedSize();
             memoizedSize = size;
             return size;
@@ -12241,6 +12469,7 @@ public final class DataPlaneContract {
             if (!getDeadLetterCACerts().equals(other.getDeadLetterCACerts())) return false;
             if (!getDeadLetterAudience().equals(other.getDeadLetterAudience())) return false;
             if (!getFormat().equals(other.getFormat())) return false;
+            if (!getRetryAttemptExtension().equals(other.getRetryAttemptExtension())) return false;
             if (getRetry() != other.getRetry()) return false;
             if (backoffPolicy_ != other.backoffPolicy_) return false;
             if (getBackoffDelay() != other.getBackoffDelay()) return false;
@@ -12264,6 +12493,8 @@ public final class DataPlaneContract {
             hash = (53 * hash) + getDeadLetterAudience().hashCode();
             hash = (37 * hash) + FORMAT_FIELD_NUMBER;
             hash = (53 * hash) + getFormat().hashCode();
+            hash = (37 * hash) + RETRYATTEMPTEXTENSION_FIELD_NUMBER;
+            hash = (53 * hash) + getRetryAttemptExtension().hashCode();
             hash = (37 * hash) + RETRY_FIELD_NUMBER;
             hash = (53 * hash) + getRetry();
             hash = (37 * hash) + BACKOFFPOLICY_FIELD_NUMBER;
@@ -12415,6 +12646,8 @@ public final class DataPlaneContract {
 
                 format_ = "";
 
+                retryAttemptExtension_ = "";
+
                 retry_ = 0;
 
                 backoffPolicy_ = 0;
@@ -12455,6 +12688,7 @@ public final class DataPlaneContract {
                 result.deadLetterCACerts_ = deadLetterCACerts_;
                 result.deadLetterAudience_ = deadLetterAudience_;
                 result.format_ = format_;
+                result.retryAttemptExtension_ = retryAttemptExtension_;
                 result.retry_ = retry_;
                 result.backoffPolicy_ = backoffPolicy_;
                 result.backoffDelay_ = backoffDelay_;
@@ -12525,6 +12759,10 @@ public final class DataPlaneContract {
                     format_ = other.format_;
                     onChanged();
                 }
+                if (!other.getRetryAttemptExtension().isEmpty()) {
+                    retryAttemptExtension_ = other.retryAttemptExtension_;
+                    onChanged();
+                }
                 if (other.getRetry() != 0) {
                     setRetry(other.getRetry());
                 }
@@ -12931,6 +13169,112 @@ public final class DataPlaneContract {
                 return this;
             }
 
+            private java.lang.Object retryAttemptExtension_ = "";
+            /**
+             * <pre>
+             * retryAttemptExtension is the name of the CloudEvent extension set to the
+             * delivery attempt number of the event, starting at 1 and incremented on each retry.
+             *
+             * Setting retryAttemptExtension to empty means don't set the extension.
+             * </pre>
+             *
+             * <code>string retryAttemptExtension = 9;</code>
+             * @return The retryAttemptExtension.
+             */
+            public java.lang.String getRetryAttemptExtension() {
+                java.lang.Object ref = retryAttemptExtension_;
+                if (!(ref instanceof java.lang.String)) {
+                    com.google.protobuf.ByteString bs = (com.google.protobuf.ByteString) ref;
+                    java.lang.String s = bs.toStringUtf8();
+                    retryAttemptExtension_ = s;
+                    return s;
+                } else {
+                    return (java.lang.String) ref;
+                }
+            }
+            /**
+             * <pre>
+             * retryAttemptExtension is the name of the CloudEvent extension set to the
+             * delivery attempt number of the event, starting at 1 and incremented on each retry.
+             *
+             * Setting retryAttemptExtension to empty means don't set the extension.
+             * </pre>
+             *
+             * <code>string retryAttemptExtension = 9;</code>
+             * @return The bytes for retryAttemptExtension.
+             */
+            public com.google.protobuf.ByteString getRetryAttemptExtensionBytes() {
+                java.lang.Object ref = retryAttemptExtension_;
+                if (ref instanceof String) {
+                    com.google.protobuf.ByteString b =
+                            com.google.protobuf.ByteString.copyFromUtf8((java.lang.String) ref);
+                    retryAttemptExtension_ = b;
+                    return b;
+                } else {
+                    return (com.google.protobuf.ByteString) ref;
+                }
+            }
+            /**
+             * <pre>
+             * retryAttemptExtension is the name of the CloudEvent extension set to the
+             * delivery attempt number of the event, starting at 1 and incremented on each retry.
+             *
+             * Setting retryAttemptExtension to empty means don't set the extension.
+             * </pre>
+             *
+             * <code>string retryAttemptExtension = 9;</code>
+             * @param value The retryAttemptExtension to set.
+             * @return This builder for chaining.
+             */
+            public Builder setRetryAttemptExtension(java.lang.String value) {
+                if (value == null) {
+                    throw new NullPointerException();
+                }
+
+                retryAttemptExtension_ = value;
+                onChanged();
+                return this;
+            }
+            /**
+             * <pre>
+             * retryAttemptExtension is the name of the CloudEvent extension set to the
+             * delivery attempt number of the event, starting at 1 and incremented on each retry.
+             *
+             * Setting retryAttemptExtension to empty means don't set the extension.
+             * </pre>
+             *
+             * <code>string retryAttemptExtension = 9;</code>
+             * @return This builder for chaining.
+             */
+            public Builder clearRetryAttemptExtension() {
+
+                retryAttemptExtension_ = getDefaultInstance().getRetryAttemptExtension();
+                onChanged();
+                return this;
+            }
+            /**
+             * <pre>
+             * retryAttemptExtension is the name of the CloudEvent extension set to the
+             * delivery attempt number of the event, starting at 1 and incremented on each retry.
+             *
+             * Setting retryAttemptExtension to empty means don't set the extension.
+             * </pre>
+             *
+             * <code>string retryAttemptExtension = 9;</code>
+             * @param value The bytes for retryAttemptExtension to set.
+             * @return This builder for chaining.
+             */
+            public Builder setRetryAttemptExtensionBytes(com.google.protobuf.ByteString value) {
+                if (value == null) {
+                    throw new NullPointerException();
+                }
+                checkByteStringIsUtf8(value);
+
+                retryAttemptExtension_ = value;
+                onChanged();
+                return this;
+            }
+
             private int retry_;
             /**
              * <pre>
@@ -13651,28 +13995,104 @@ public final class DataPlaneContract {
          * @return The bytes for oidcServiceAccountName.
          */
         com.google.protobuf.ByteString getOidcServiceAccountNameBytes();
-
-        public dev.knative.eventing.kafka.broker.contract.DataPlaneContract.Egress.ReplyStrategyCase
-                getReplyStrategyCase();
-    }
-    /**
-     * Protobuf type {@code Egress}
-     */
-    public static final class Egress extends com.google.protobuf.GeneratedMessageV3
-            implements
-            // @@protoc_insertion_point(message_implements:Egress)
-            EgressOrBuilder {
-        private static final long serialVersionUID = 0L;
-        // Use Egress.newBuilder() to construct.
-        private Egress(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
-            super(builder);
-        }
-
-        private Egress() {
-            consumerGroup_ = "";
-            destination_ = "";
-            destinationCACerts_ = "";
-            destinationAudience_ = "";
+        /**
+         * <pre>
+         * Rebalance protocol of the consumer group.
+         * Empty means the configured assignors are used as they are.
+         * </pre>
+         *
+         * <code>.RebalanceProtocol rebalanceProtocol = 20;</code>
+         * @return The enum numeric value on the wire for rebalanceProtocol.
+         */
+        int getRebalanceProtocolValue();
+        /**
+         * <pre>
+         * Rebalance protocol of the consumer group.
+         * Empty means the configured assignors are used as they are.
+         * </pre>
+         *
+         * <code>.RebalanceProtocol rebalanceProtocol = 20;</code>
+         * @return The rebalanceProtocol.
+         */
+        dev.knative.eventing.kafka.broker.contract.DataPlaneContract.RebalanceProtocol getRebalanceProtocol();
+        /**
+         * <pre>
+         * auditSink is the sink where a CloudEvent is sent on each rebalance of the consumer group.
+         * Empty means don't send rebalance events.
+         * </pre>
+         *
+         * <code>string auditSink = 21;</code>
+         * @return The auditSink.
+         */
+        java.lang.String getAuditSink();
+        /**
+         * <pre>
+         * auditSink is the sink where a CloudEvent is sent on each rebalance of the consumer group.
+         * Empty means don't send rebalance events.
+         * </pre>
+         *
+         * <code>string auditSink = 21;</code>
+         * @return The bytes for auditSink.
+         */
+        com.google.protobuf.ByteString getAuditSinkBytes();
+        /**
+         * <pre>
+         * auditSink CA Cert is the CA Cert used for HTTPS communication through auditSink
+         * </pre>
+         *
+         * <code>string auditSinkCACerts = 22;</code>
+         * @return The auditSinkCACerts.
+         */
+        java.lang.String getAuditSinkCACerts();
+        /**
+         * <pre>
+         * auditSink CA Cert is the CA Cert used for HTTPS communication through auditSink
+         * </pre>
+         *
+         * <code>string auditSinkCACerts = 22;</code>
+         * @return The bytes for auditSinkCACerts.
+         */
+        com.google.protobuf.ByteString getAuditSinkCACertsBytes();
+        /**
+         * <pre>
+         * OIDC audience of the auditSink
+         * </pre>
+         *
+         * <code>string auditSinkAudience = 23;</code>
+         * @return The auditSinkAudience.
+         */
+        java.lang.String getAuditSinkAudience();
+        /**
+         * <pre>
+         * OIDC audience of the auditSink
+         * </pre>
+         *
+         * <code>string auditSinkAudience = 23;</code>
+         * @return The bytes for auditSinkAudience.
+         */
+        com.google.protobuf.ByteString getAuditSinkAudienceBytes();
+
+        public dev.knative.eventing.kafka.broker.contract.DataPlaneContract.Egress.ReplyStrategyCase
+                getReplyStrategyCase();
+    }
+    /**
+     * Protobuf type {@code Egress}
+     */
+    public static final class Egress extends com.google.protobuf.GeneratedMessageV3
+            implements
+            // @@protoc_insertion_point(message_implements:Egress)
+            EgressOrBuilder {
+        private static final long serialVersionUID = 0L;
+        // Use Egress.newBuilder() to construct.
+        private Egress(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+            super(builder);
+        }
+
+        private Egress() {
+            consumerGroup_ = "";
+            destination_ = "";
+            destinationCACerts_ = "";
+            destinationAudience_ = "";
             replyUrlCACerts_ = "";
             replyUrlAudience_ = "";
             uid_ = "";
@@ -13680,6 +14100,10 @@ public final class DataPlaneContract {
             keyType_ = 0;
             dialectedFilter_ = java.util.Collections.emptyList();
             oidcServiceAccountName_ = "";
+            rebalanceProtocol_ = 0;
+            auditSink_ = "";
+            auditSinkCACerts_ = "";
+            auditSinkAudience_ = "";
         }
 
         @java.lang.Override
@@ -13898,6 +14322,30 @@ public final class DataPlaneContract {
                             oidcServiceAccountName_ = s;
                             break;
                         }
+                        case 160: {
+                            int rawValue = input.readEnum();
+
+                            rebalanceProtocol_ = rawValue;
+                            break;
+                        }
+                        case 170: {
+                            java.lang.String s = input.readStringRequireUtf8();
+
+                            auditSink_ = s;
+                            break;
+                        }
+                        case 178: {
+                            java.lang.String s = input.readStringRequireUtf8();
+
+                            auditSinkCACerts_ = s;
+                            break;
+                        }
+                        case 186: {
+                            java.lang.String s = input.readStringRequireUtf8();
+
+                            auditSinkAudience_ = s;
+                            break;
+                        }
                         default: {
                             if (!parseUnknownField(input, unknownFields, extensionRegistry, tag)) {
                                 done = true;
@@ -14786,6 +15234,169 @@ public final class DataPlaneContract {
             }
         }
 
+        public static final int REBALANCEPROTOCOL_FIELD_NUMBER = 20;
+        private int rebalanceProtocol_;
+        /**
+         * <pre>
+         * Rebalance protocol of the consumer group.
+         * Empty means the configured assignors are used as they are.
+         * </pre>
+         *
+         * <code>.RebalanceProtocol rebalanceProtocol = 20;</code>
+         * @return The enum numeric value on the wire for rebalanceProtocol.
+         */
+        @java.lang.Override
+        public int getRebalanceProtocolValue() {
+            return rebalanceProtocol_;
+        }
+        /**
+         * <pre>
+         * Rebalance protocol of the consumer group.
+         * Empty means the configured assignors are used as they are.
+         * </pre>
+         *
+         * <code>.RebalanceProtocol rebalanceProtocol = 20;</code>
+         * @return The rebalanceProtocol.
+         */
+        @java.lang.Override
+        public dev.knative.eventing.kafka.broker.contract.DataPlaneContract.RebalanceProtocol getRebalanceProtocol() {
+            @SuppressWarnings("deprecation")
+            dev.knative.eventing.kafka.broker.contract.DataPlaneContract.RebalanceProtocol result =
+                    dev.knative.eventing.kafka.broker.contract.DataPlaneContract.RebalanceProtocol.valueOf(
+                            rebalanceProtocol_);
+            return result == null
+                    ? dev.knative.eventing.kafka.broker.contract.DataPlaneContract.RebalanceProtocol.UNRECOGNIZED
+                    : result;
+        }
+
+        public static final int AUDITSINK_FIELD_NUMBER = 21;
+        private volatile java.lang.Object auditSink_;
+        /**
+         * <pre>
+         * auditSink is the sink where a CloudEvent is sent on each rebalance of the consumer group.
+         * Empty means don't send rebalance events.
+         * </pre>
+         *
+         * <code>string auditSink = 21;</code>
+         * @return The auditSink.
+         */
+        @java.lang.Override
+        public java.lang.String getAuditSink() {
+            java.lang.Object ref = auditSink_;
+            if (ref instanceof java.lang.String) {
+                return (java.lang.String) ref;
+            } else {
+                com.google.protobuf.ByteString bs = (com.google.protobuf.ByteString) ref;
+                java.lang.String s = bs.toStringUtf8();
+                auditSink_ = s;
+                return s;
+            }
+        }
+        /**
+         * <pre>
+         * auditSink is the sink where a CloudEvent is sent on each rebalance of the consumer group.
+         * Empty means don't send rebalance events.
+         * </pre>
+         *
+         * <code>string auditSink = 21;</code>
+         * @return The bytes for auditSink.
+         */
+        @java.lang.Override
+        public com.google.protobuf.ByteString getAuditSinkBytes() {
+            java.lang.Object ref = auditSink_;
+            if (ref instanceof java.lang.String) {
+                com.google.protobuf.ByteString b = com.google.protobuf.ByteString.copyFromUtf8((java.lang.String) ref);
+                auditSink_ = b;
+                return b;
+            } else {
+                return (com.google.protobuf.ByteString) ref;
+            }
+        }
+
+        public static final int AUDITSINKCACERTS_FIELD_NUMBER = 22;
+        private volatile java.lang.Object auditSinkCACerts_;
+        /**
+         * <pre>
+         * auditSink CA Cert is the CA Cert used for HTTPS communication through auditSink
+         * </pre>
+         *
+         * <code>string auditSinkCACerts = 22;</code>
+         * @return The auditSinkCACerts.
+         */
+        @java.lang.Override
+        public java.lang.String getAuditSinkCACerts() {
+            java.lang.Object ref = auditSinkCACerts_;
+            if (ref instanceof java.lang.String) {
+                return (java.lang.String) ref;
+            } else {
+                com.google.protobuf.ByteString bs = (com.google.protobuf.ByteString) ref;
+                java.lang.String s = bs.toStringUtf8();
+                auditSinkCACerts_ = s;
+                return s;
+            }
+        }
+        /**
+         * <pre>
+         * auditSink CA Cert is the CA Cert used for HTTPS communication through auditSink
+         * </pre>
+         *
+         * <code>string auditSinkCACerts = 22;</code>
+         * @return The bytes for auditSinkCACerts.
+         */
+        @java.lang.Override
+        public com.google.protobuf.ByteString getAuditSinkCACertsBytes() {
+            java.lang.Object ref = auditSinkCACerts_;
+            if (ref instanceof java.lang.String) {
+                com.google.protobuf.ByteString b = com.google.protobuf.ByteString.copyFromUtf8((java.lang.String) ref);
+                auditSinkCACerts_ = b;
+                return b;
+            } else {
+                return (com.google.protobuf.ByteString) ref;
+            }
+        }
+
+        public static final int AUDITSINKAUDIENCE_FIELD_NUMBER = 23;
+        private volatile java.lang.Object auditSinkAudience_;
+        /**
+         * <pre>
+         * OIDC audience of the auditSink
+         * </pre>
+         *
+         * <code>string auditSinkAudience = 23;</code>
+         * @return The auditSinkAudience.
+         */
+        @java.lang.Override
+        public java.lang.String getAuditSinkAudience() {
+            java.lang.Object ref = auditSinkAudience_;
+            if (ref instanceof java.lang.String) {
+                return (java.lang.String) ref;
+            } else {
+                com.google.protobuf.ByteString bs = (com.google.protobuf.ByteString) ref;
+                java.lang.String s = bs.toStringUtf8();
+                auditSinkAudience_ = s;
+                return s;
+            }
+        }
+        /**
+         * <pre>
+         * OIDC audience of the auditSink
+         * </pre>
+         *
+         * <code>string auditSinkAudience = 23;</code>
+         * @return The bytes for auditSinkAudience.
+         */
+        @java.lang.Override
+        public com.google.protobuf.ByteString getAuditSinkAudienceBytes() {
+            java.lang.Object ref = auditSinkAudience_;
+            if (ref instanceof java.lang.String) {
+                com.google.protobuf.ByteString b = com.google.protobuf.ByteString.copyFromUtf8((java.lang.String) ref);
+                auditSinkAudience_ = b;
+                return b;
+            } else {
+                return (com.google.protobuf.ByteString) ref;
+            }
+        }
+
         private byte memoizedIsInitialized = -1;
 
         @java.lang.Override
@@ -14861,6 +15472,20 @@ public final class DataPlaneContract {
             if (!getOidcServiceAccountNameBytes().isEmpty()) {
                 com.google.protobuf.GeneratedMessageV3.writeString(output, 19, oidcServiceAccountName_);
             }
+            if (rebalanceProtocol_
+                    != dev.knative.eventing.kafka.broker.contract.DataPlaneContract.RebalanceProtocol.UNSPECIFIED
+                            .getNumber()) {
+                output.writeEnum(20, rebalanceProtocol_);
+            }
+            if (!getAuditSinkBytes().isEmpty()) {
+                com.google.protobuf.GeneratedMessageV3.writeString(output, 21, auditSink_);
+            }
+            if (!getAuditSinkCACertsBytes().isEmpty()) {
+                com.google.protobuf.GeneratedMessageV3.writeString(output, 22, auditSinkCACerts_);
+            }
+            if (!getAuditSinkAudienceBytes().isEmpty()) {
+                com.google.protobuf.GeneratedMessageV3.writeString(output, 23, auditSinkAudience_);
+            }
             unknownFields.writeTo(output);
         }
 
@@ -14931,6 +15556,20 @@ public final class DataPlaneContract {
             if (!getOidcServiceAccountNameBytes().isEmpty()) {
                 size += com.google.protobuf.GeneratedMessageV3.computeStringSize(19, oidcServiceAccountName_);
             }
+            if (rebalanceProtocol_
+                    != dev.knative.eventing.kafka.broker.contract.DataPlaneContract.RebalanceProtocol.UNSPECIFIED
+                            .getNumber()) {
+                size += com.google.protobuf.CodedOutputStream.computeEnumSize(20, rebalanceProtocol_);
+            }
+            if (!getAuditSinkBytes().isEmpty()) {
+                size += com.google.protobuf.GeneratedMessageV3.computeStringSize(21, auditSink_);
+            }
+            if (!getAuditSinkCACertsBytes().isEmpty()) {
+                size += com.google.protobuf.GeneratedMessageV3.computeStringSize(22, auditSinkCACerts_);
+            }
+            if (!getAuditSinkAudienceBytes().isEmpty()) {
+                size += com.google.protobuf.GeneratedMessageV3.computeStringSize(23, auditSinkAudience_);
+            }
             size += unknownFields.getSerializedSize();
             memoizedSize = size;
             return size;
@@ -14975,6 +15614,10 @@ public final class DataPlaneContract {
                 if (!getFeatureFlags().equals(other.getFeatureFlags())) return false;
             }
             if (!getOidcServiceAccountName().equals(other.getOidcServiceAccountName())) return false;
+            if (rebalanceProtocol_ != other.rebalanceProtocol_) return false;
+            if (!getAuditSink().equals(other.getAuditSink())) return false;
+            if (!getAuditSinkCACerts().equals(other.getAuditSinkCACerts())) return false;
+            if (!getAuditSinkAudience().equals(other.getAuditSinkAudience())) return false;
             if (!getReplyStrategyCase().equals(other.getReplyStrategyCase())) return false;
             switch (replyStrategyCase_) {
                 case 3:
@@ -15042,6 +15685,14 @@ public final class DataPlaneContract {
             }
             hash = (37 * hash) + OIDCSERVICEACCOUNTNAME_FIELD_NUMBER;
             hash = (53 * hash) + getOidcServiceAccountName().hashCode();
+            hash = (37 * hash) + REBALANCEPROTOCOL_FIELD_NUMBER;
+            hash = (53 * hash) + rebalanceProtocol_;
+            hash = (37 * hash) + AUDITSINK_FIELD_NUMBER;
+            hash = (53 * hash) + getAuditSink().hashCode();
+            hash = (37 * hash) + AUDITSINKCACERTS_FIELD_NUMBER;
+            hash = (53 * hash) + getAuditSinkCACerts().hashCode();
+            hash = (37 * hash) + AUDITSINKAUDIENCE_FIELD_NUMBER;
+            hash = (53 * hash) + getAuditSinkAudience().hashCode();
             switch (replyStrategyCase_) {
                 case 3:
                     hash = (37 * hash) + REPLYURL_FIELD_NUMBER;
@@ -15245,6 +15896,14 @@ public final class DataPlaneContract {
                 }
                 oidcServiceAccountName_ = "";
 
+                rebalanceProtocol_ = 0;
+
+                auditSink_ = "";
+
+                auditSinkCACerts_ = "";
+
+                auditSinkAudience_ = "";
+
                 replyStrategyCase_ = 0;
                 replyStrategy_ = null;
                 return this;
@@ -15331,6 +15990,10 @@ public final class DataPlaneContract {
                     result.featureFlags_ = featureFlagsBuilder_.build();
                 }
                 result.oidcServiceAccountName_ = oidcServiceAccountName_;
+                result.rebalanceProtocol_ = rebalanceProtocol_;
+                result.auditSink_ = auditSink_;
+                result.auditSinkCACerts_ = auditSinkCACerts_;
+                result.auditSinkAudience_ = auditSinkAudience_;
                 result.replyStrategyCase_ = replyStrategyCase_;
                 onBuilt();
                 return result;
@@ -15460,6 +16123,21 @@ public final class DataPlaneContract {
                     oidcServiceAccountName_ = other.oidcServiceAccountName_;
                     onChanged();
                 }
+                if (other.rebalanceProtocol_ != 0) {
+                    setRebalanceProtocolValue(other.getRebalanceProtocolValue());
+                }
+                if (!other.getAuditSink().isEmpty()) {
+                    auditSink_ = other.auditSink_;
+                    onChanged();
+                }
+                if (!other.getAuditSinkCACerts().isEmpty()) {
+                    auditSinkCACerts_ = other.auditSinkCACerts_;
+                    onChanged();
+                }
+                if (!other.getAuditSinkAudience().isEmpty()) {
+                    auditSinkAudience_ = other.auditSinkAudience_;
+                    onChanged();
+                }
                 switch (other.getReplyStrategyCase()) {
                     case REPLYURL: {
                         replyStrategyCase_ = 3;
@@ -18024,6 +18702,370 @@ public final class DataPlaneContract {
                 return this;
             }
 
+            private int rebalanceProtocol_ = 0;
+            /**
+             * <pre>
+             * Rebalance protocol of the consumer group.
+             * Empty means the configured assignors are used as they are.
+             * </pre>
+             *
+             * <code>.RebalanceProtocol rebalanceProtocol = 20;</code>
+             * @return The enum numeric value on the wire for rebalanceProtocol.
+             */
+            @java.lang.Override
+            public int getRebalanceProtocolValue() {
+                return rebalanceProtocol_;
+            }
+            /**
+             * <pre>
+             * Rebalance protocol of the consumer group.
+             * Empty means the configured assignors are used as they are.
+             * </pre>
+             *
+             * <code>.RebalanceProtocol rebalanceProtocol = 20;</code>
+             * @param value The enum numeric value on the wire for rebalanceProtocol to set.
+             * @return This builder for chaining.
+             */
+            public Builder setRebalanceProtocolValue(int value) {
+
+                rebalanceProtocol_ = value;
+                onChanged();
+                return this;
+            }
+            /**
+             * <pre>
+             * Rebalance protocol of the consumer group.
+             * Empty means the configured assignors are used as they are.
+             * </pre>
+             *
+             * <code>.RebalanceProtocol rebalanceProtocol = 20;</code>
+             * @return The rebalanceProtocol.
+             */
+            @java.lang.Override
+            public dev.knative.eventing.kafka.broker.contract.DataPlaneContract.RebalanceProtocol
+                    getRebalanceProtocol() {
+                @SuppressWarnings("deprecation")
+                dev.knative.eventing.kafka.broker.contract.DataPlaneContract.RebalanceProtocol result =
+                        dev.knative.eventing.kafka.broker.contract.DataPlaneContract.RebalanceProtocol.valueOf(
+                                rebalanceProtocol_);
+                return result == null
+                        ? dev.knative.eventing.kafka.broker.contract.DataPlaneContract.RebalanceProtocol.UNRECOGNIZED
+                        : result;
+            }
+            /**
+             * <pre>
+             * Rebalance protocol of the consumer group.
+             * Empty means the configured assignors are used as they are.
+             * </pre>
+             *
+             * <code>.RebalanceProtocol rebalanceProtocol = 20;</code>
+             * @param value The rebalanceProtocol to set.
+             * @return This builder for chaining.
+             */
+            public Builder setRebalanceProtocol(
+                    dev.knative.eventing.kafka.broker.contract.DataPlaneContract.RebalanceProtocol value) {
+                if (value == null) {
+                    throw new NullPointerException();
+                }
+
+                rebalanceProtocol_ = value.getNumber();
+                onChanged();
+                return this;
+            }
+            /**
+             * <pre>
+             * Rebalance protocol of the consumer group.
+             * Empty means the configured assignors are used as they are.
+             * </pre>
+             *
+             * <code>.RebalanceProtocol rebalanceProtocol = 20;</code>
+             * @return This builder for chaining.
+             */
+            public Builder clearRebalanceProtocol() {
+
+                rebalanceProtocol_ = 0;
+                onChanged();
+                return this;
+            }
+
+            private java.lang.Object auditSink_ = "";
+            /**
+             * <pre>
+             * auditSink is the sink where a CloudEvent is sent on each rebalance of the consumer group.
+             * Empty means don't send rebalance events.
+             * </pre>
+             *
+             * <code>string auditSink = 21;</code>
+             * @return The auditSink.
+             */
+            public java.lang.String getAuditSink() {
+                java.lang.Object ref = auditSink_;
+                if (!(ref instanceof java.lang.String)) {
+                    com.google.protobuf.ByteString bs = (com.google.protobuf.ByteString) ref;
+                    java.lang.String s = bs.toStringUtf8();
+                    auditSink_ = s;
+                    return s;
+                } else {
+                    return (java.lang.String) ref;
+                }
+            }
+            /**
+             * <pre>
+             * auditSink is the sink where a CloudEvent is sent on each rebalance of the consumer group.
+             * Empty means don't send rebalance events.
+             * </pre>
+             *
+             * <code>string auditSink = 21;</code>
+             * @return The bytes for auditSink.
+             */
+            public com.google.protobuf.ByteString getAuditSinkBytes() {
+                java.lang.Object ref = auditSink_;
+                if (ref instanceof String) {
+                    com.google.protobuf.ByteString b =
+                            com.google.protobuf.ByteString.copyFromUtf8((java.lang.String) ref);
+                    auditSink_ = b;
+                    return b;
+                } else {
+                    return (com.google.protobuf.ByteString) ref;
+                }
+            }
+            /**
+             * <pre>
+             * auditSink is the sink where a CloudEvent is sent on each rebalance of the consumer group.
+             * Empty means don't send rebalance events.
+             * </pre>
+             *
+             * <code>string auditSink = 21;</code>
+             * @param value The auditSink to set.
+             * @return This builder for chaining.
+             */
+            public Builder setAuditSink(java.lang.String value) {
+                if (value == null) {
+                    throw new NullPointerException();
+                }
+
+                auditSink_ = value;
+                onChanged();
+                return this;
+            }
+            /**
+             * <pre>
+             * auditSink is the sink where a CloudEvent is sent on each rebalance of the consumer group.
+             * Empty means don't send rebalance events.
+             * </pre>
+             *
+             * <code>string auditSink = 21;</code>
+             * @return This builder for chaining.
+             */
+            public Builder clearAuditSink() {
+
+                auditSink_ = getDefaultInstance().getAuditSink();
+                onChanged();
+                return this;
+            }
+            /**
+             * <pre>
+             * auditSink is the sink where a CloudEvent is sent on each rebalance of the consumer group.
+             * Empty means don't send rebalance events.
+             * </pre>
+             *
+             * <code>string auditSink = 21;</code>
+             * @param value The bytes for auditSink to set.
+             * @return This builder for chaining.
+             */
+            public Builder setAuditSinkBytes(com.google.protobuf.ByteString value) {
+                if (value == null) {
+                    throw new NullPointerException();
+                }
+                checkByteStringIsUtf8(value);
+
+                auditSink_ = value;
+                onChanged();
+                return this;
+            }
+
+            private java.lang.Object auditSinkCACerts_ = "";
+            /**
+             * <pre>
+             * auditSink CA Cert is the CA Cert used for HTTPS communication through auditSink
+             * </pre>
+             *
+             * <code>string auditSinkCACerts = 22;</code>
+             * @return The auditSinkCACerts.
+             */
+            public java.lang.String getAuditSinkCACerts() {
+                java.lang.Object ref = auditSinkCACerts_;
+                if (!(ref instanceof java.lang.String)) {
+                    com.google.protobuf.ByteString bs = (com.google.protobuf.ByteString) ref;
+                    java.lang.String s = bs.toStringUtf8();
+                    auditSinkCACerts_ = s;
+                    return s;
+                } else {
+                    return (java.lang.String) ref;
+                }
+            }
+            /**
+             * <pre>
+             * auditSink CA Cert is the CA Cert used for HTTPS communication through auditSink
+             * </pre>
+             *
+             * <code>string auditSinkCACerts = 22;</code>
+             * @return The bytes for auditSinkCACerts.
+             */
+            public com.google.protobuf.ByteString getAuditSinkCACertsBytes() {
+                java.lang.Object ref = auditSinkCACerts_;
+                if (ref instanceof String) {
+                    com.google.protobuf.ByteString b =
+                            com.google.protobuf.ByteString.copyFromUtf8((java.lang.String) ref);
+                    auditSinkCACerts_ = b;
+                    return b;
+                } else {
+                    return (com.google.protobuf.ByteString) ref;
+                }
+            }
+            /**
+             * <pre>
+             * auditSink CA Cert is the CA Cert used for HTTPS communication through auditSink
+             * </pre>
+             *
+             * <code>string auditSinkCACerts = 22;</code>
+             * @param value The auditSinkCACerts to set.
+             * @return This builder for chaining.
+             */
+            public Builder setAuditSinkCACerts(java.lang.String value) {
+                if (value == null) {
+                    throw new NullPointerException();
+                }
+
+                auditSinkCACerts_ = value;
+                onChanged();
+                return this;
+            }
+            /**
+             * <pre>
+             * auditSink CA Cert is the CA Cert used for HTTPS communication through auditSink
+             * </pre>
+             *
+             * <code>string auditSinkCACerts = 22;</code>
+             * @return This builder for chaining.
+             */
+            public Builder clearAuditSinkCACerts() {
+
+                auditSinkCACerts_ = getDefaultInstance().getAuditSinkCACerts();
+                onChanged();
+                return this;
+            }
+            /**
+             * <pre>
+             * auditSink CA Cert is the CA Cert used for HTTPS communication through auditSink
+             * </pre>
+             *
+             * <code>string auditSinkCACerts = 22;</code>
+             * @param value The bytes for auditSinkCACerts to set.
+             * @return This builder for chaining.
+             */
+            public Builder setAuditSinkCACertsBytes(com.google.protobuf.ByteString value) {
+                if (value == null) {
+                    throw new NullPointerException();
+                }
+                checkByteStringIsUtf8(value);
+
+                auditSinkCACerts_ = value;
+                onChanged();
+                return this;
+            }
+
+            private java.lang.Object auditSinkAudience_ = "";
+            /**
+             * <pre>
+             * OIDC audience of the auditSink
+             * </pre>
+             *
+             * <code>string auditSinkAudience = 23;</code>
+             * @return The auditSinkAudience.
+             */
+            public java.lang.String getAuditSinkAudience() {
+                java.lang.Object ref = auditSinkAudience_;
+                if (!(ref instanceof java.lang.String)) {
+                    com.google.protobuf.ByteString bs = (com.google.protobuf.ByteString) ref;
+                    java.lang.String s = bs.toStringUtf8();
+                    auditSinkAudience_ = s;
+                    return s;
+                } else {
+                    return (java.lang.String) ref;
+                }
+            }
+            /**
+             * <pre>
+             * OIDC audience of the auditSink
+             * </pre>
+             *
+             * <code>string auditSinkAudience = 23;</code>
+             * @return The bytes for auditSinkAudience.
+             */
+            public com.google.protobuf.ByteString getAuditSinkAudienceBytes() {
+                java.lang.Object ref = auditSinkAudience_;
+                if (ref instanceof String) {
+                    com.google.protobuf.ByteString b =
+                            com.google.protobuf.ByteString.copyFromUtf8((java.lang.String) ref);
+                    auditSinkAudience_ = b;
+                    return b;
+                } else {
+                    return (com.google.protobuf.ByteString) ref;
+                }
+            }
+            /**
+             * <pre>
+             * OIDC audience of the auditSink
+             * </pre>
+             *
+             * <code>string auditSinkAudience = 23;</code>
+             * @param value The auditSinkAudience to set.
+             * @return This builder for chaining.
+             */
+            public Builder setAuditSinkAudience(java.lang.String value) {
+                if (value == null) {
+                    throw new NullPointerException();
+                }
+
+                auditSinkAudience_ = value;
+                onChanged();
+                return this;
+            }
+            /**
+             * <pre>
+             * OIDC audience of the auditSink
+             * </pre>
+             *
+             * <code>string auditSinkAudience = 23;</code>
+             * @return This builder for chaining.
+             */
+            public Builder clearAuditSinkAudience() {
+
+                auditSinkAudience_ = getDefaultInstance().getAuditSinkAudience();
+                onChanged();
+                return this;
+            }
+            /**
+             * <pre>
+             * OIDC audience of the auditSink
+             * </pre>
+             *
+             * <code>string auditSinkAudience = 23;</code>
+             * @param value The bytes for auditSinkAudience to set.
+             * @return This builder for chaining.
+             */
+            public Builder setAuditSinkAudienceBytes(com.google.protobuf.ByteString value) {
+                if (value == null) {
+                    throw new NullPointerException();
+                }
+                checkByteStringIsUtf8(value);
+
+                auditSinkAudience_ = value;
+                onChanged();
+                return this;
+            }
+
             @java.lang.Override
             public final Builder setUnknownFields(final com.google.protobuf.UnknownFieldSet unknownFields) {
                 return super.setUnknownFields(unknownFields);
@@ -31874,69 +32916,75 @@ public final class DataPlaneContract {
                     + "\031\n\006prefix\030\002 \001(\0132\007.PrefixH\000B\t\n\007matcher\"V\n"
                     + "\013EventPolicy\022$\n\rtokenMatchers\030\001 \003(\0132\r.To"
                     + "kenMatcher\022!\n\007filters\030\002 \003(\0132\020.DialectedF"
-                    + "ilter\"\306\001\n\014EgressConfig\022\022\n\ndeadLetter\030\001 \001"
+                    + "ilter\"\345\001\n\014EgressConfig\022\022\n\ndeadLetter\030\001 \001"
                     + "(\t\022\031\n\021deadLetterCACerts\030\006 \001(\t\022\032\n\022deadLet"
-                    + "terAudience\030\007 \001(\t\022\016\n\006format\030\010 \001(\t\022\r\n\005ret"
-                    + "ry\030\002 \001(\r\022%\n\rbackoffPolicy\030\003 \001(\0162\016.Backof"
-                    + "fPolicy\022\024\n\014backoffDelay\030\004 \001(\004\022\017\n\007timeout"
-                    + "\030\005 \001(\004\"\302\004\n\006Egress\022\025\n\rconsumerGroup\030\001 \001(\t"
-                    + "\022\023\n\013destination\030\002 \001(\t\022\032\n\022destinationCACe"
-                    + "rts\030\017 \001(\t\022\033\n\023destinationAudience\030\021 \001(\t\022\022"
-                    + "\n\010replyUrl\030\003 \001(\tH\000\022&\n\024replyToOriginalTop"
-                    + "ic\030\004 \001(\0132\006.EmptyH\000\022\036\n\014discardReply\030\t \001(\013"
-                    + "2\006.EmptyH\000\022\027\n\017replyUrlCACerts\030\020 \001(\t\022\030\n\020r"
-                    + "eplyUrlAudience\030\022 \001(\t\022\027\n\006filter\030\005 \001(\0132\007."
-                    + "Filter\022\013\n\003uid\030\006 \001(\t\022#\n\014egressConfig\030\007 \001("
-                    + "\0132\r.EgressConfig\022%\n\rdeliveryOrder\030\010 \001(\0162"
-                    + "\016.DeliveryOrder\022\031\n\007keyType\030\n \001(\0162\010.KeyTy"
-                    + "pe\022\035\n\treference\030\013 \001(\0132\n.Reference\022)\n\017dia"
-                    + "lectedFilter\030\014 \003(\0132\020.DialectedFilter\022\021\n\t"
-                    + "vReplicas\030\r \001(\005\022)\n\014featureFlags\030\016 \001(\0132\023."
-                    + "EgressFeatureFlags\022\036\n\026oidcServiceAccount"
-                    + "Name\030\023 \001(\tB\017\n\rreplyStrategy\"U\n\022EgressFea"
-                    + "tureFlags\022\031\n\021enableRateLimiter\030\001 \001(\010\022$\n\034"
-                    + "enableOrderedExecutorMetrics\030\002 \001(\010\"\177\n\007In"
-                    + "gress\022!\n\013contentMode\030\001 \001(\0162\014.ContentMode"
-                    + "\022\014\n\004path\030\002 \001(\t\022\014\n\004host\030\003 \001(\t\022\020\n\010audience"
-                    + "\030\005 \001(\t\022#\n\reventPolicies\030\006 \003(\0132\014.EventPol"
-                    + "icy\"o\n\tReference\022\014\n\004uuid\030\001 \001(\t\022\021\n\tnamesp"
-                    + "ace\030\002 \001(\t\022\014\n\004name\030\003 \001(\t\022\017\n\007version\030\004 \001(\t"
-                    + "\022\014\n\004kind\030\005 \001(\t\022\024\n\014groupVersion\030\006 \001(\t\"`\n\017"
-                    + "SecretReference\022\035\n\treference\030\001 \001(\0132\n.Ref"
-                    + "erence\022.\n\022keyFieldReferences\030\002 \003(\0132\022.Key"
-                    + "FieldReference\"C\n\021KeyFieldReference\022\021\n\ts"
-                    + "ecretKey\030\002 \001(\t\022\033\n\005field\030\003 \001(\0162\014.SecretFi"
-                    + "eld\"Y\n\024MultiSecretReference\022\033\n\010protocol\030"
-                    + "\001 \001(\0162\t.Protocol\022$\n\nreferences\030\002 \003(\0132\020.S"
-                    + "ecretReference\"\202\001\n\023CloudEventOverrides\0228"
-                    + "\n\nextensions\030\001 \003(\0132$.CloudEventOverrides"
-                    + ".ExtensionsEntry\0321\n\017ExtensionsEntry\022\013\n\003k"
-                    + "ey\030\001 \001(\t\022\r\n\005value\030\002 \001(\t:\0028\001\"1\n\014FeatureFl"
-                    + "ags\022!\n\031enableEventTypeAutocreate\030\001 \001(\010\"\215"
-                    + "\003\n\010Resource\022\013\n\003uid\030\001 \001(\t\022\016\n\006topics\030\002 \003(\t"
-                    + "\022\030\n\020bootstrapServers\030\003 \001(\t\022\031\n\007ingress\030\004 "
-                    + "\001(\0132\010.Ingress\022#\n\014egressConfig\030\005 \001(\0132\r.Eg"
-                    + "ressConfig\022\031\n\010egresses\030\006 \003(\0132\007.Egress\022\034\n"
-                    + "\nabsentAuth\030\007 \001(\0132\006.EmptyH\000\022 \n\nauthSecre"
-                    + "t\030\010 \001(\0132\n.ReferenceH\000\0220\n\017multiAuthSecret"
-                    + "\030\t \001(\0132\025.MultiSecretReferenceH\000\0221\n\023cloud"
-                    + "EventOverrides\030\n \001(\0132\024.CloudEventOverrid"
-                    + "es\022\035\n\treference\030\013 \001(\0132\n.Reference\022#\n\014fea"
-                    + "tureFlags\030\014 \001(\0132\r.FeatureFlagsB\006\n\004Auth\"R"
-                    + "\n\010Contract\022\022\n\ngeneration\030\001 \001(\004\022\034\n\tresour"
-                    + "ces\030\002 \003(\0132\t.Resource\022\024\n\014trustBundles\030\003 \003"
-                    + "(\t*,\n\rBackoffPolicy\022\017\n\013Exponential\020\000\022\n\n\006"
-                    + "Linear\020\001*+\n\rDeliveryOrder\022\r\n\tUNORDERED\020\000"
-                    + "\022\013\n\007ORDERED\020\001*=\n\007KeyType\022\n\n\006String\020\000\022\013\n\007"
-                    + "Integer\020\001\022\n\n\006Double\020\002\022\r\n\tByteArray\020\003*)\n\013"
-                    + "ContentMode\022\n\n\006BINARY\020\000\022\016\n\nSTRUCTURED\020\001*"
-                    + "a\n\013SecretField\022\022\n\016SASL_MECHANISM\020\000\022\n\n\006CA"
-                    + "_CRT\020\001\022\014\n\010USER_CRT\020\002\022\014\n\010USER_KEY\020\003\022\010\n\004US"
-                    + "ER\020\004\022\014\n\010PASSWORD\020\005*D\n\010Protocol\022\r\n\tPLAINT"
-                    + "EXT\020\000\022\022\n\016SASL_PLAINTEXT\020\001\022\007\n\003SSL\020\002\022\014\n\010SA"
-                    + "SL_SSL\020\003B[\n*dev.knative.eventing.kafka.b"
-                    + "roker.contractB\021DataPlaneContractZ\032contr"
-                    + "ol-plane/pkg/contractb\006proto3"
+                    + "terAudience\030\007 \001(\t\022\016\n\006format\030\010 \001(\t\022\035\n\025ret"
+                    + "ryAttemptExtension\030\t \001(\t\022\r\n\005retry\030\002 \001(\r\022"
+                    + "%\n\rbackoffPolicy\030\003 \001(\0162\016.BackoffPolicy\022\024"
+                    + "\n\014backoffDelay\030\004 \001(\004\022\017\n\007timeout\030\005 \001(\004\"\271\005"
+                    + "\n\006Egress\022\025\n\rconsumerGroup\030\001 \001(\t\022\023\n\013desti"
+                    + "nation\030\002 \001(\t\022\032\n\022destinationCACerts\030\017 \001(\t"
+                    + "\022\033\n\023destinationAudience\030\021 \001(\t\022\022\n\010replyUr"
+                    + "l\030\003 \001(\tH\000\022&\n\024replyToOriginalTopic\030\004 \001(\0132"
+                    + "\006.EmptyH\000\022\036\n\014discardReply\030\t \001(\0132\006.EmptyH"
+                    + "\000\022\027\n\017replyUrlCACerts\030\020 \001(\t\022\030\n\020replyUrlAu"
+                    + "dience\030\022 \001(\t\022\027\n\006filter\030\005 \001(\0132\007.Filter\022\013\n"
+                    + "\003uid\030\006 \001(\t\022#\n\014egressConfig\030\007 \001(\0132\r.Egres"
+                    + "sConfig\022%\n\rdeliveryOrder\030\010 \001(\0162\016.Deliver"
+                    + "yOrder\022\031\n\007keyType\030\n \001(\0162\010.KeyType\022\035\n\tref"
+                    + "erence\030\013 \001(\0132\n.Reference\022)\n\017dialectedFil"
+                    + "ter\030\014 \003(\0132\020.DialectedFilter\022\021\n\tvReplicas"
+                    + "\030\r \001(\005\022)\n\014featureFlags\030\016 \001(\0132\023.EgressFea"
+                    + "tureFlags\022\036\n\026oidcServiceAccountName\030\023 \001("
+                    + "\t\022-\n\021rebalanceProtocol\030\024 \001(\0162\022.Rebalance"
+                    + "Protocol\022\021\n\tauditSink\030\025 \001(\t\022\030\n\020auditSink"
+                    + "CACerts\030\026 \001(\t\022\031\n\021auditSinkAudience\030\027 \001(\t"
+                    + "B\017\n\rreplyStrategy\"U\n\022EgressFeatureFlags\022"
+                    + "\031\n\021enableRateLimiter\030\001 \001(\010\022$\n\034enableOrde"
+                    + "redExecutorMetrics\030\002 \001(\010\"\177\n\007Ingress\022!\n\013c"
+                    + "ontentMode\030\001 \001(\0162\014.ContentMode\022\014\n\004path\030\002"
+                    + " \001(\t\022\014\n\004host\030\003 \001(\t\022\020\n\010audience\030\005 \001(\t\022#\n\r"
+                    + "eventPolicies\030\006 \003(\0132\014.EventPolicy\"o\n\tRef"
+                    + "erence\022\014\n\004uuid\030\001 \001(\t\022\021\n\tnamespace\030\002 \001(\t\022"
+                    + "\014\n\004name\030\003 \001(\t\022\017\n\007version\030\004 \001(\t\022\014\n\004kind\030\005"
+                    + " \001(\t\022\024\n\014groupVersion\030\006 \001(\t\"`\n\017SecretRefe"
+                    + "rence\022\035\n\treference\030\001 \001(\0132\n.Reference\022.\n\022"
+                    + "keyFieldReferences\030\002 \003(\0132\022.KeyFieldRefer"
+                    + "ence\"C\n\021KeyFieldReference\022\021\n\tsecretKey\030\002"
+                    + " \001(\t\022\033\n\005field\030\003 \001(\0162\014.SecretField\"Y\n\024Mul"
+                    + "tiSecretReference\022\033\n\010protocol\030\001 \001(\0162\t.Pr"
+                    + "otocol\022$\n\nreferences\030\002 \003(\0132\020.SecretRefer"
+                    + "ence\"\202\001\n\023CloudEventOverrides\0228\n\nextensio"
+                    + "ns\030\001 \003(\0132$.CloudEventOverrides.Extension"
+                    + "sEntry\0321\n\017ExtensionsEntry\022\013\n\003key\030\001 \001(\t\022\r"
+                    + "\n\005value\030\002 \001(\t:\0028\001\"1\n\014FeatureFlags\022!\n\031ena"
+                    + "bleEventTypeAutocreate\030\001 \001(\010\"\215\003\n\010Resourc"
+                    + "e\022\013\n\003uid\030\001 \001(\t\022\016\n\006topics\030\002 \003(\t\022\030\n\020bootst"
+                    + "rapServers\030\003 \001(\t\022\031\n\007ingress\030\004 \001(\0132\010.Ingr"
+                    + "ess\022#\n\014egressConfig\030\005 \001(\0132\r.EgressConfig"
+                    + "\022\031\n\010egresses\030\006 \003(\0132\007.Egress\022\034\n\nabsentAut"
+                    + "h\030\007 \001(\0132\006.EmptyH\000\022 \n\nauthSecret\030\010 \001(\0132\n."
+                    + "ReferenceH\000\0220\n\017multiAuthSecret\030\t \001(\0132\025.M"
+                    + "ultiSecretReferenceH\000\0221\n\023cloudEventOverr"
+                    + "ides\030\n \001(\0132\024.CloudEventOverrides\022\035\n\trefe"
+                    + "rence\030\013 \001(\0132\n.Reference\022#\n\014featureFlags\030"
+                    + "\014 \001(\0132\r.FeatureFlagsB\006\n\004Auth\"R\n\010Contract"
+                    + "\022\022\n\ngeneration\030\001 \001(\004\022\034\n\tresources\030\002 \003(\0132"
+                    + "\t.Resource\022\024\n\014trustBundles\030\003 \003(\t*,\n\rBack"
+                    + "offPolicy\022\017\n\013Exponential\020\000\022\n\n\006Linear\020\001*+"
+                    + "\n\rDeliveryOrder\022\r\n\tUNORDERED\020\000\022\013\n\007ORDERE"
+                    + "D\020\001*=\n\007KeyType\022\n\n\006String\020\000\022\013\n\007Integer\020\001\022"
+                    + "\n\n\006Double\020\002\022\r\n\tByteArray\020\003*@\n\021RebalanceP"
+                    + "rotocol\022\017\n\013UNSPECIFIED\020\000\022\t\n\005EAGER\020\001\022\017\n\013C"
+                    + "OOPERATIVE\020\002*)\n\013ContentMode\022\n\n\006BINARY\020\000\022"
+                    + "\016\n\nSTRUCTURED\020\001*a\n\013SecretField\022\022\n\016SASL_M"
+                    + "ECHANISM\020\000\022\n\n\006CA_CRT\020\001\022\014\n\010USER_CRT\020\002\022\014\n\010"
+                    + "USER_KEY\020\003\022\010\n\004USER\020\004\022\014\n\010PASSWORD\020\005*D\n\010Pr"
+                    + "otocol\022\r\n\tPLAINTEXT\020\000\022\022\n\016SASL_PLAINTEXT\020"
+                    + "\001\022\007\n\003SSL\020\002\022\014\n\010SASL_SSL\020\003B[\n*dev.knative."
+                    + "eventing.kafka.broker.contractB\021DataPlan"
+                    + "eContractZ\032control-plane/pkg/contractb\006p"
+                    + "roto3"
         };
         descriptor = com.google.protobuf.Descriptors.FileDescriptor.internalBuildGeneratedFileFrom(
                 descriptorData, new com.google.protobuf.Descriptors.FileDescriptor[] {});
@@ -32038,6 +33086,7 @@ public final class DataPlaneContract {
                     "DeadLetterCACerts",
                     "DeadLetterAudience",
                     "Format",
+                    "RetryAttemptExtension",
                     "Retry",
                     "BackoffPolicy",
                     "BackoffDelay",
@@ -32065,6 +33114,10 @@ public final class DataPlaneContract {
                     "VReplicas",
                     "FeatureFlags",
                     "OidcServiceAccountName",
+                    "RebalanceProtocol",
+                    "AuditSink",
+                    "AuditSinkCACerts",
+                    "AuditSinkAudience",
                     "ReplyStrategy",
                 });
         internal_static_EgressFeatureFlags_descriptor =
@@ -273,6 +273,10 @@ public class ResourcesReconcilerImpl implements ResourcesReconciler {
                 && Objects.equals(e1.getReplyUrlAudience(), e2.getReplyUrlAudience())
                 && Objects.equals(e1.getOidcServiceAccountName(), e2.getOidcServiceAccountName())
                 && Objects.equals(e1.getReference(), e2.getReference())
+                && Objects.equals(e1.getRebalanceProtocol(), e2.getRebalanceProtocol())
+                && Objects.equals(e1.getAuditSink(), e2.getAuditSink())
+                && Objects.equals(e1.getAuditSinkCACerts(), e2.getAuditSinkCACerts())
+                && Objects.equals(e1.getAuditSinkAudience(), e2.getAuditSinkAudience())
                 && Objects.equals(
                         e1.getEgressConfig().getDeadLetterCACerts(),
                         e2.getEgressConfig().getDeadLetterCACerts())
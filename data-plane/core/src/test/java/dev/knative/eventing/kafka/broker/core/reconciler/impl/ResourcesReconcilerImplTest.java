@@ -704,6 +704,70 @@ class ResourcesReconcilerImplTest {
                 .run();
     }
 
+    @Test
+    void reconcileEgressModifyingTheRebalanceProtocol() {
+        new ResourceReconcilerTestRunner()
+                .enableEgressListener()
+                .reconcile(List.of(
+                        baseResource("1-1234").addEgresses(egress("aaa")).build()))
+                .expect()
+                .newEgress("aaa")
+                .then()
+                .reconcile(List.of(baseResource("1-1234")
+                        .addEgresses(
+                                baseEgress("aaa").setRebalanceProtocol(DataPlaneContract.RebalanceProtocol.COOPERATIVE))
+                        .build()))
+                .expect()
+                .updatedEgress("aaa")
+                .then()
+                .reconcile(List.of(
+                        baseResource("1-1234").addEgresses(egress("aaa")).build()))
+                .expect()
+                .updatedEgress("aaa")
+                .then()
+                .run();
+    }
+
+    @Test
+    void reconcileEgressModifyingTheAuditSink() {
+        new ResourceReconcilerTestRunner()
+                .enableEgressListener()
+                .reconcile(List.of(
+                        baseResource("1-1234").addEgresses(egress("aaa")).build()))
+                .expect()
+                .newEgress("aaa")
+                .then()
+                .reconcile(List.of(baseResource("1-1234")
+                        .addEgresses(baseEgress("aaa").setAuditSink("http://audit1.example.com"))
+                        .build()))
+                .expect()
+                .updatedEgress("aaa")
+                .then()
+                .reconcile(List.of(baseResource("1-1234")
+                        .addEgresses(baseEgress("aaa")
+                                .setAuditSink("https://audit1.example.com")
+                                .setAuditSinkCACerts("ca-certs"))
+                        .build()))
+                .expect()
+                .updatedEgress("aaa")
+                .then()
+                .reconcile(List.of(baseResource("1-1234")
+                        .addEgresses(baseEgress("aaa")
+                                .setAuditSink("https://audit1.example.com")
+                                .setAuditSinkCACerts("ca-certs")
+                                .setAuditSinkAudience("audience"))
+                        .build()))
+                .expect()
+                .updatedEgress("aaa")
+                .then()
+                .reconcile(List.of(
+                        baseResource("1-1234").addEgresses(egress("aaa")).build()))
+                .expect()
+                .updatedEgress("aaa")
+                .then()
+                .run();
+    }
+
     @Test
     void reconcileEgressModifyingAuthConfig() {
         final var uuid = UUID.randomUUID().toString();
@@ -0,0 +1,133 @@
+/*
+ * Copyright © 2018 Knative Authors (knative-dev@googlegroups.com)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dev.knative.eventing.kafka.broker.dispatcher.impl.consumer;
+
+import static dev.knative.eventing.kafka.broker.core.utils.Logging.keyValue;
+
+import dev.knative.eventing.kafka.broker.contract.DataPlaneContract;
+import dev.knative.eventing.kafka.broker.core.AsyncCloseable;
+import dev.knative.eventing.kafka.broker.dispatcher.CloudEventSender;
+import io.cloudevents.CloudEvent;
+import io.cloudevents.core.builder.CloudEventBuilder;
+import io.vertx.core.Future;
+import io.vertx.core.json.JsonArray;
+import io.vertx.core.json.JsonObject;
+import java.net.URI;
+import java.time.OffsetDateTime;
+import java.time.ZoneOffset;
+import java.util.Collection;
+import java.util.Comparator;
+import java.util.HashSet;
+import java.util.Set;
+import java.util.UUID;
+import org.apache.kafka.common.TopicPartition;
+import org.slf4j.Logger;
+import org.slf4j.LoggerFactory;
+
+/**
+ * The {@link RebalanceAuditor} tracks the partitions assigned to a consumer and sends a {@link CloudEvent} with the
+ * partitions assigned before and after each rebalance.
+ * <p>
+ * With the eager protocol every partition is revoked at the beginning of a rebalance, while with the cooperative
+ * protocol only the partitions moving to another consumer are revoked, however in both cases a rebalance ends with
+ * the partitions assigned, so that's where the event is sent.
+ * <p>
+ * Events are sent independently of the events dispatched to the subscriber, a failure sending an event is only
+ * logged and never blocks the rebalance.
+ */
+public class RebalanceAuditor implements AsyncCloseable {
+
+    private static final Logger logger = LoggerFactory.getLogger(RebalanceAuditor.class);
+
+    public static final String TYPE = "dev.knative.kafka.rebalance";
+
+    private static final Comparator<TopicPartition> TOPIC_PARTITION_COMPARATOR =
+            Comparator.comparing(TopicPartition::topic).thenComparingInt(TopicPartition::partition);
+
+    private final CloudEventSender sender;
+    private final DataPlaneContract.Reference reference;
+    private final String consumerGroup;
+
+    private final Set<TopicPartition> assignment;
+    // Partitions assigned when the current rebalance started, null when there is no rebalance in progress.
+    private Set<TopicPartition> beforeRebalance;
+
+    public RebalanceAuditor(
+            final CloudEventSender sender, final DataPlaneContract.Reference reference, final String consumerGroup) {
+        this.sender = sender;
+        this.reference = reference;
+        this.consumerGroup = consumerGroup;
+        this.assignment = new HashSet<>();
+    }
+
+    public synchronized void partitionsRevoked(final Collection<TopicPartition> partitions) {
+        if (beforeRebalance == null) {
+            beforeRebalance = new HashSet<>(assignment);
+        }
+        assignment.removeAll(partitions);
+    }
+
+    public synchronized Future<Void> partitionsAssigned(final Collection<TopicPartition> partitions) {
+        final var before = beforeRebalance != null ? beforeRebalance : new HashSet<>(assignment);
+        beforeRebalance = null;
+        assignment.addAll(partitions);
+
+        final var event = rebalanceEvent(before, assignment);
+        return sender.send(event)
+                .onFailure(cause -> logger.warn(
+                        "Failed to send rebalance event {} {}",
+                        keyValue("consumerGroup", consumerGroup),
+                        keyValue("id", event.getId()),
+                        cause))
+                .<Void>mapEmpty()
+                .otherwiseEmpty();
+    }
+
+    private CloudEvent rebalanceEvent(final Set<TopicPartition> before, final Set<TopicPartition> after) {
+        final var data = new JsonObject()
+                .put("consumerGroup", consumerGroup)
+                .put("before", toJson(before))
+                .put("after", toJson(after));
+
+        return CloudEventBuilder.v1()
+                .withId(UUID.randomUUID().toString())
+                .withType(TYPE)
+                .withSource(source())
+                .withSubject(consumerGroup)
+                .withTime(OffsetDateTime.now(ZoneOffset.UTC))
+                .withData("application/json", data.toBuffer().getBytes())
+                .build();
+    }
+
+    private URI source() {
+        return URI.create("/apis/" + reference.getGroupVersion() + "/namespaces/" + reference.getNamespace() + "/"
+                + reference.getKind().toLowerCase() + "s/" + reference.getName());
+    }
+
+    private static JsonArray toJson(final Set<TopicPartition> partitions) {
+        final var array = new JsonArray();
+        partitions.stream()
+                .sorted(TOPIC_PARTITION_COMPARATOR)
+                .forEach(tp -> array.add(
+                        new JsonObject().put("topic", tp.topic()).put("partition", tp.partition())));
+        return array;
+    }
+
+    @Override
+    public Future<Void> close() {
+        return sender.close();
+    }
+}
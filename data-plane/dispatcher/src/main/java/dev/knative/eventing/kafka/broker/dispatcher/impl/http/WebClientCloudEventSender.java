@@ -26,6 +26,7 @@ import dev.knative.eventing.kafka.broker.dispatcher.impl.ResponseFailureExceptio
 import dev.knative.eventing.kafka.broker.dispatcher.impl.auth.TokenProvider;
 import dev.knative.eventing.kafka.broker.dispatcher.main.ConsumerVerticleContext;
 import io.cloudevents.CloudEvent;
+import io.cloudevents.core.builder.CloudEventBuilder;
 import io.cloudevents.http.vertx.VertxMessageFactory;
 import io.cloudevents.rw.CloudEventRWException;
 import io.micrometer.core.instrument.Tags;
@@ -63,9 +64,10 @@ public final class WebClientCloudEventSender implements CloudEventSender {
     private final AtomicBoolean closed = new AtomicBoolean(false);
     private final AtomicInteger inFlightRequests = new AtomicInteger(0);
     private final TokenProvider tokenProvider;
+    private final String retryAttemptExtension;
 
     /**
-     * All args constructor.
+     * Constructor for senders that don't set the delivery attempt extension.
      *
      * @param vertx                   Vertx context instance
      * @param client                  http client.
@@ -80,6 +82,35 @@ public final class WebClientCloudEventSender implements CloudEventSender {
             final NamespacedName oidcServiceAccount,
             final ConsumerVerticleContext consumerVerticleContext,
             final Tags additionalTags) {
+        this(
+                vertx,
+                client,
+                target,
+                targetOIDCAudience,
+                oidcServiceAccount,
+                consumerVerticleContext,
+                additionalTags,
+                "");
+    }
+
+    /**
+     * All args constructor.
+     *
+     * @param vertx                   Vertx context instance
+     * @param client                  http client.
+     * @param target                  subscriber URI
+     * @param consumerVerticleContext consumer verticle context
+     * @param retryAttemptExtension   CloudEvent extension set to the delivery attempt number, empty to not set it
+     */
+    public WebClientCloudEventSender(
+            final Vertx vertx,
+            final WebClient client,
+            final String target,
+            final String targetOIDCAudience,
+            final NamespacedName oidcServiceAccount,
+            final ConsumerVerticleContext consumerVerticleContext,
+            final Tags additionalTags,
+            final String retryAttemptExtension) {
         Objects.requireNonNull(vertx);
         Objects.requireNonNull(client, "provide client");
         Objects.requireNonNull(additionalTags, "provide additional tags");
@@ -98,6 +129,7 @@ public final class WebClientCloudEventSender implements CloudEventSender {
         this.consumerVerticleContext = consumerVerticleContext;
         this.retryPolicyFunc = computeRetryPolicy(consumerVerticleContext.getEgressConfig());
         this.tokenProvider = new TokenProvider(vertx);
+        this.retryAttemptExtension = retryAttemptExtension == null ? "" : retryAttemptExtension;
 
         Metrics.eventDispatchInFlightCount(
                         additionalTags.and(consumerVerticleContext.getTags()), this.inFlightRequests::get)
@@ -127,7 +159,7 @@ public final class WebClientCloudEventSender implements CloudEventSender {
                 TracingSpan.decorateCurrentWithConsumer(consumerVerticleContext.getEgress());
                 requestEmitted();
                 // here we send the event
-                send(event, promise).onComplete(v -> requestCompleted());
+                send(withDeliveryAttempt(event, retryCounter), promise).onComplete(v -> requestCompleted());
             } catch (CloudEventRWException e) {
                 logger.error(
                         "failed to write event to the request {} {}",
@@ -177,6 +209,16 @@ public final class WebClientCloudEventSender implements CloudEventSender {
         return r.future();
     }
 
+    private CloudEvent withDeliveryAttempt(final CloudEvent event, final int retryCounter) {
+        if (retryAttemptExtension.isEmpty()) {
+            return event;
+        }
+        // The first delivery is attempt 1, each retry increments it.
+        return CloudEventBuilder.from(event)
+                .withExtension(retryAttemptExtension, retryCounter + 1)
+                .build();
+    }
+
     private void requestCompleted() {
         inFlightRequests.decrementAndGet();
         if (closed.get() && inFlightRequests.get() == 0) {
@@ -18,6 +18,7 @@ package dev.knative.eventing.kafka.broker.dispatcher.main;
 import static dev.knative.eventing.kafka.broker.core.utils.Logging.keyValue;
 
 import dev.knative.eventing.kafka.broker.contract.DataPlaneContract;
+import dev.knative.eventing.kafka.broker.core.AsyncCloseable;
 import dev.knative.eventing.kafka.broker.core.NamespacedName;
 import dev.knative.eventing.kafka.broker.core.ReactiveKafkaConsumer;
 import dev.knative.eventing.kafka.broker.core.ReactiveKafkaProducer;
@@ -40,6 +41,7 @@ import dev.knative.eventing.kafka.broker.dispatcher.impl.consumer.ConsumerVertic
 import dev.knative.eventing.kafka.broker.dispatcher.impl.consumer.OffsetManager;
 import dev.knative.eventing.kafka.broker.dispatcher.impl.consumer.OrderedConsumerVerticle;
 import dev.knative.eventing.kafka.broker.dispatcher.impl.consumer.PartitionRevokedHandler;
+import dev.knative.eventing.kafka.broker.dispatcher.impl.consumer.RebalanceAuditor;
 import dev.knative.eventing.kafka.broker.dispatcher.impl.consumer.UnorderedConsumerVerticle;
 import dev.knative.eventing.kafka.broker.dispatcher.impl.http.WebClientCloudEventSender;
 import io.cloudevents.CloudEvent;
@@ -92,7 +94,8 @@ public class ConsumerVerticleBuilder {
         consumerVerticle.setConsumer(consumer);
 
         final var metricsCloser = Metrics.register(consumer.unwrap());
-        consumerVerticle.setCloser(metricsCloser);
+        final var rebalanceAuditor = createRebalanceAuditor(vertx);
+        consumerVerticle.setCloser(AsyncCloseable.compose(metricsCloser, rebalanceAuditor));
 
         // setting up cloud events sender
         final var egressSubscriberSender = createConsumerRecordSender(vertx);
@@ -120,7 +123,7 @@ public class ConsumerVerticleBuilder {
 
         final var partitionRevokedHandlers =
                 List.of(consumerVerticle.getPartitionRevokedHandler(), offsetManager.getPartitionRevokedHandler());
-        consumerVerticle.setRebalanceListener(createRebalanceListener(partitionRevokedHandlers));
+        consumerVerticle.setRebalanceListener(createRebalanceListener(partitionRevokedHandlers, rebalanceAuditor));
     }
 
     private ConsumerVerticle createConsumerVerticle(final ConsumerVerticle.Initializer initializer) {
@@ -135,10 +138,11 @@ public class ConsumerVerticleBuilder {
      * For each handler call partitionRevoked and wait for the future to complete.
      *
      * @param partitionRevokedHandlers partition revoked handlers
+     * @param rebalanceAuditor         rebalance auditor, null when no audit sink is set
      * @return ConsumerRebalanceListener object with the partition revoked handler running on onPartitionsRevoked
      */
     private ConsumerRebalanceListener createRebalanceListener(
-            final List<PartitionRevokedHandler> partitionRevokedHandlers) {
+            final List<PartitionRevokedHandler> partitionRevokedHandlers, final RebalanceAuditor rebalanceAuditor) {
         return new ConsumerRebalanceListener() {
             @Override
             public void onPartitionsRevoked(Collection<TopicPartition> partitions) {
@@ -147,6 +151,10 @@ public class ConsumerVerticleBuilder {
                         consumerVerticleContext.getLoggingKeyValue(),
                         keyValue("partitions", partitions));
 
+                if (rebalanceAuditor != null) {
+                    rebalanceAuditor.partitionsRevoked(partitions);
+                }
+
                 final var futures = new ArrayList<Future<Void>>(partitionRevokedHandlers.size());
                 for (PartitionRevokedHandler partitionRevokedHandler : partitionRevokedHandlers) {
                     futures.add(partitionRevokedHandler.partitionRevoked(partitions));
@@ -170,6 +178,11 @@ public class ConsumerVerticleBuilder {
                         "Received assign partitions for consumer {} {}",
                         consumerVerticleContext.getLoggingKeyValue(),
                         keyValue("partitions", partitions));
+
+                if (rebalanceAuditor != null) {
+                    // Don't wait for the rebalance event to be sent, it's independent of the consumer progress.
+                    rebalanceAuditor.partitionsAssigned(partitions);
+                }
             }
         };
     }
@@ -255,7 +268,8 @@ public class ConsumerVerticleBuilder {
                         consumerVerticleContext.getResource().getReference().getNamespace(),
                         consumerVerticleContext.getEgress().getOidcServiceAccountName()),
                 consumerVerticleContext,
-                Metrics.Tags.senderContext("subscriber"));
+                Metrics.Tags.senderContext("subscriber"),
+                consumerVerticleContext.getEgressConfig().getRetryAttemptExtension());
     }
 
     private CloudEventSender createDeadLetterSinkRecordSender(final Vertx vertx) {
@@ -283,6 +297,25 @@ public class ConsumerVerticleBuilder {
         return NO_DEAD_LETTER_SINK_SENDER;
     }
 
+    private RebalanceAuditor createRebalanceAuditor(final Vertx vertx) {
+        final var egress = consumerVerticleContext.getEgress();
+        if (egress.getAuditSink().isEmpty()) {
+            return null;
+        }
+
+        final var sender = new WebClientCloudEventSender(
+                vertx,
+                WebClient.create(vertx, createWebClientOptionsFromCACerts(egress.getAuditSinkCACerts())),
+                egress.getAuditSink(),
+                egress.getAuditSinkAudience(),
+                new NamespacedName(
+                        consumerVerticleContext.getResource().getReference().getNamespace(),
+                        egress.getOidcServiceAccountName()),
+                consumerVerticleContext,
+                Metrics.Tags.senderContext("auditsink"));
+        return new RebalanceAuditor(sender, egress.getReference(), egress.getConsumerGroup());
+    }
+
     private int getCommitIntervalMs() {
         final var commitInterval =
                 consumerVerticleContext.getConsumerConfigs().get(ConsumerConfig.AUTO_COMMIT_INTERVAL_MS_CONFIG);
@@ -35,12 +35,18 @@ import io.micrometer.core.instrument.MeterRegistry;
 import io.micrometer.core.instrument.Tag;
 import io.micrometer.core.instrument.Tags;
 import io.vertx.ext.web.client.WebClientOptions;
+import java.util.ArrayList;
+import java.util.Collection;
 import java.util.Collections;
 import java.util.HashMap;
+import java.util.List;
 import java.util.Map;
 import java.util.Objects;
 import java.util.Set;
 import org.apache.kafka.clients.consumer.ConsumerConfig;
+import org.apache.kafka.clients.consumer.ConsumerPartitionAssignor;
+import org.apache.kafka.clients.consumer.CooperativeStickyAssignor;
+import org.apache.kafka.clients.consumer.RangeAssignor;
 import org.apache.kafka.clients.producer.ProducerConfig;
 import org.slf4j.Logger;
 import org.slf4j.LoggerFactory;
@@ -104,6 +110,13 @@ public class ConsumerVerticleContext {
 
         consumerConfigs.put(ConsumerConfig.GROUP_ID_CONFIG, egress.getConsumerGroup());
         consumerConfigs.put(KeyDeserializer.KEY_TYPE, egress.getKeyType());
+        if (egress.getRebalanceProtocol() != DataPlaneContract.RebalanceProtocol.UNSPECIFIED) {
+            consumerConfigs.put(
+                    ConsumerConfig.PARTITION_ASSIGNMENT_STRATEGY_CONFIG,
+                    assignmentStrategies(
+                            consumerConfigs.get(ConsumerConfig.PARTITION_ASSIGNMENT_STRATEGY_CONFIG),
+                            egress.getRebalanceProtocol()));
+        }
         if (isResourceReferenceDefined(resource.getReference())) {
             // Set the resource reference so that when the interceptor gets a record that is not a CloudEvent, it can
             // set
@@ -290,6 +303,64 @@ public class ConsumerVerticleContext {
         return (int) total;
     }
 
+    /**
+     * Kafka uses the eager protocol as long as one of the assignors of a consumer only supports the eager protocol.
+     * With the eager protocol, the configured eager assignors are preferred and the cooperative sticky assignor is
+     * listed as a fallback, while with the cooperative protocol the assignors not supporting it are dropped.
+     * <p>
+     * Dispatcher replicas receive a protocol change at different times, so while switching protocol some members of
+     * the group run with the old assignors and others with the new ones, and the cooperative sticky assignor is the
+     * assignor they have in common, following Kafka's rolling upgrade path.
+     *
+     * @param configured configured assignors
+     * @param protocol   rebalance protocol
+     * @return the assignors to use, in order of preference
+     */
+    static String assignmentStrategies(final Object configured, final DataPlaneContract.RebalanceProtocol protocol) {
+        final var configuredAssignors = new ArrayList<String>();
+        if (configured instanceof Collection<?> configuredList) {
+            configuredList.forEach(assignor -> configuredAssignors.add(assignorName(assignor)));
+        } else if (configured != null) {
+            for (final var assignor : configured.toString().split(",")) {
+                if (!assignor.isBlank()) {
+                    configuredAssignors.add(assignor.trim());
+                }
+            }
+        }
+
+        final var cooperative = CooperativeStickyAssignor.class.getName();
+        final var assignors = new ArrayList<String>();
+        if (protocol == DataPlaneContract.RebalanceProtocol.COOPERATIVE) {
+            assignors.add(cooperative);
+            configuredAssignors.stream()
+                    .filter(assignor -> !assignor.equals(cooperative) && supportsCooperativeProtocol(assignor))
+                    .forEach(assignors::add);
+        } else {
+            configuredAssignors.stream()
+                    .filter(assignor -> !supportsCooperativeProtocol(assignor))
+                    .forEach(assignors::add);
+            if (assignors.isEmpty()) {
+                // Kafka's default eager assignor.
+                assignors.add(RangeAssignor.class.getName());
+            }
+            assignors.add(cooperative);
+        }
+        return String.join(",", assignors);
+    }
+
+    private static boolean supportsCooperativeProtocol(final String assignor) {
+        final var cooperative = ConsumerPartitionAssignor.RebalanceProtocol.COOPERATIVE;
+        return ConsumerPartitionAssignor.getAssignorInstances(List.of(assignor), Map.of()).stream()
+                .allMatch(instance -> instance.supportedProtocols().contains(cooperative));
+    }
+
+    private static String assignorName(final Object assignor) {
+        if (assignor instanceof Class<?> assignorClass) {
+            return assignorClass.getName();
+        }
+        return assignor.toString().trim();
+    }
+
     private static boolean isResourceReferenceDefined(DataPlaneContract.Reference resource) {
         return resource != null
                 && !resource.getNamespace().isBlank()
@@ -0,0 +1,161 @@
+/*
+ * Copyright © 2018 Knative Authors (knative-dev@googlegroups.com)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dev.knative.eventing.kafka.broker.dispatcher.impl.consumer;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.mockito.ArgumentMatchers.any;
+import static org.mockito.Mockito.mock;
+import static org.mockito.Mockito.never;
+import static org.mockito.Mockito.times;
+import static org.mockito.Mockito.verify;
+import static org.mockito.Mockito.when;
+
+import dev.knative.eventing.kafka.broker.contract.DataPlaneContract;
+import dev.knative.eventing.kafka.broker.dispatcher.CloudEventSender;
+import io.cloudevents.CloudEvent;
+import io.vertx.core.Future;
+import io.vertx.core.json.JsonArray;
+import io.vertx.core.json.JsonObject;
+import java.net.URI;
+import java.util.List;
+import org.apache.kafka.common.TopicPartition;
+import org.junit.jupiter.api.Test;
+import org.mockito.ArgumentCaptor;
+
+public class RebalanceAuditorTest {
+
+    private static final DataPlaneContract.Reference REFERENCE = DataPlaneContract.Reference.newBuilder()
+            .setNamespace("ns")
+            .setName("name")
+            .setKind("KafkaSource")
+            .setGroupVersion("sources.knative.dev/v1beta1")
+            .build();
+
+    @Test
+    public void shouldSendEventOnFirstAssignment() {
+        final var sender = sender();
+        final var auditor = new RebalanceAuditor(sender, REFERENCE, "group");
+
+        auditor.partitionsAssigned(List.of(new TopicPartition("t1", 1), new TopicPartition("t1", 0)));
+
+        final var events = sentEvents(sender, 1);
+        final var event = events.get(0);
+        assertThat(event.getType()).isEqualTo(RebalanceAuditor.TYPE);
+        assertThat(event.getSource())
+                .isEqualTo(URI.create("/apis/sources.knative.dev/v1beta1/namespaces/ns/kafkasources/name"));
+        assertThat(event.getSubject()).isEqualTo("group");
+        assertThat(event.getDataContentType()).isEqualTo("application/json");
+        assertThat(event.getTime()).isNotNull();
+
+        final var data = data(event);
+        assertThat(data.getString("consumerGroup")).isEqualTo("group");
+        assertThat(data.getJsonArray("before")).isEqualTo(new JsonArray());
+        assertThat(data.getJsonArray("after")).isEqualTo(partitions("t1", 0, "t1", 1));
+    }
+
+    @Test
+    public void shouldSendEventOnEagerRebalance() {
+        final var sender = sender();
+        final var auditor = new RebalanceAuditor(sender, REFERENCE, "group");
+
+        auditor.partitionsAssigned(List.of(new TopicPartition("t1", 0), new TopicPartition("t1", 1)));
+        // With the eager protocol every partition is revoked first.
+        auditor.partitionsRevoked(List.of(new TopicPartition("t1", 0), new TopicPartition("t1", 1)));
+        auditor.partitionsAssigned(List.of(new TopicPartition("t1", 1), new TopicPartition("t2", 0)));
+
+        final var data = data(sentEvents(sender, 2).get(1));
+        assertThat(data.getJsonArray("before")).isEqualTo(partitions("t1", 0, "t1", 1));
+        assertThat(data.getJsonArray("after")).isEqualTo(partitions("t1", 1, "t2", 0));
+    }
+
+    @Test
+    public void shouldSendEventOnCooperativeRebalance() {
+        final var sender = sender();
+        final var auditor = new RebalanceAuditor(sender, REFERENCE, "group");
+
+        auditor.partitionsAssigned(List.of(new TopicPartition("t1", 0), new TopicPartition("t1", 1)));
+        // With the cooperative protocol only moving partitions are revoked, and assigned partitions are
+        // incremental.
+        auditor.partitionsRevoked(List.of(new TopicPartition("t1", 0)));
+        auditor.partitionsAssigned(List.of());
+        auditor.partitionsAssigned(List.of(new TopicPartition("t2", 0)));
+
+        final var events = sentEvents(sender, 3);
+
+        final var revoked = data(events.get(1));
+        assertThat(revoked.getJsonArray("before")).isEqualTo(partitions("t1", 0, "t1", 1));
+        assertThat(revoked.getJsonArray("after")).isEqualTo(partitions("t1", 1));
+
+        final var assigned = data(events.get(2));
+        assertThat(assigned.getJsonArray("before")).isEqualTo(partitions("t1", 1));
+        assertThat(assigned.getJsonArray("after")).isEqualTo(partitions("t1", 1, "t2", 0));
+    }
+
+    @Test
+    public void shouldNotSendEventOnRevokeOnly() {
+        final var sender = sender();
+        final var auditor = new RebalanceAuditor(sender, REFERENCE, "group");
+
+        auditor.partitionsRevoked(List.of(new TopicPartition("t1", 0)));
+
+        verify(sender, never()).send(any());
+    }
+
+    @Test
+    public void shouldNotFailOnSendFailure() {
+        final var sender = mock(CloudEventSender.class);
+        when(sender.send(any())).thenReturn(Future.failedFuture(new IllegalStateException("failed")));
+        final var auditor = new RebalanceAuditor(sender, REFERENCE, "group");
+
+        final var future = auditor.partitionsAssigned(List.of(new TopicPartition("t1", 0)));
+
+        assertThat(future.succeeded()).isTrue();
+    }
+
+    @Test
+    public void shouldCloseSender() {
+        final var sender = sender();
+        when(sender.close()).thenReturn(Future.succeededFuture());
+        final var auditor = new RebalanceAuditor(sender, REFERENCE, "group");
+
+        assertThat(auditor.close().succeeded()).isTrue();
+        verify(sender).close();
+    }
+
+    private static CloudEventSender sender() {
+        final var sender = mock(CloudEventSender.class);
+        when(sender.send(any())).thenReturn(Future.succeededFuture());
+        return sender;
+    }
+
+    private static List<CloudEvent> sentEvents(final CloudEventSender sender, final int count) {
+        final var captor = ArgumentCaptor.forClass(CloudEvent.class);
+        verify(sender, times(count)).send(captor.capture());
+        return captor.getAllValues();
+    }
+
+    private static JsonObject data(final CloudEvent event) {
+        return new JsonObject(new String(event.getData().toBytes()));
+    }
+
+    private static JsonArray partitions(final Object... topicPartitions) {
+        final var array = new JsonArray();
+        for (int i = 0; i < topicPartitions.length; i += 2) {
+            array.add(new JsonObject().put("topic", topicPartitions[i]).put("partition", topicPartitions[i + 1]));
+        }
+        return array;
+    }
+}
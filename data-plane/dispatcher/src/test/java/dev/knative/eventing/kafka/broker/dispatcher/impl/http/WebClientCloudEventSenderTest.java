@@ -42,6 +42,7 @@ import io.vertx.micrometer.MicrometerMetricsOptions;
 import io.vertx.micrometer.backends.BackendRegistries;
 import java.net.URI;
 import java.util.UUID;
+import java.util.concurrent.CopyOnWriteArrayList;
 import java.util.concurrent.ExecutionException;
 import java.util.concurrent.atomic.AtomicBoolean;
 import java.util.concurrent.atomic.LongAdder;
@@ -151,6 +152,180 @@ public class WebClientCloudEventSenderTest {
         sender.close().onSuccess(v -> context.completeNow());
     }
 
+    @Test
+    @Timeout(value = 20000)
+    public void shouldIncrementRetryAttemptExtension(final Vertx vertx, final VertxTestContext context)
+            throws ExecutionException, InterruptedException {
+
+        final var port = 12349;
+        final var retry = 5;
+        final var event = CloudEventBuilder.v1()
+                .withId(UUID.randomUUID().toString())
+                .withSource(URI.create("/api/v1/orders"))
+                .withType("dev.knative.eventing.created")
+                .build();
+
+        final var attempts = new CopyOnWriteArrayList<String>();
+
+        vertx.createHttpServer()
+                .requestHandler(r -> {
+                    attempts.add(r.getHeader("Ce-Knativeerrorattempt"));
+                    if (attempts.size() == 3) {
+                        r.response().setStatusCode(200).end();
+                    } else {
+                        r.response().setStatusCode(500).end();
+                    }
+                })
+                .listen(port, "localhost")
+                .toCompletionStage()
+                .toCompletableFuture()
+                .get();
+
+        final var sender = new WebClientCloudEventSender(
+                vertx,
+                WebClient.create(vertx),
+                "http://localhost:" + port,
+                "",
+                new NamespacedName("", ""),
+                FakeConsumerVerticleContext.get(
+                        FakeConsumerVerticleContext.get().getResource(),
+                        DataPlaneContract.Egress.newBuilder(
+                                        FakeConsumerVerticleContext.get().getEgress())
+                                .setEgressConfig(DataPlaneContract.EgressConfig.newBuilder()
+                                        .setBackoffDelay(100L)
+                                        .setTimeout(1000L)
+                                        .setBackoffPolicy(DataPlaneContract.BackoffPolicy.Linear)
+                                        .setRetry(retry)
+                                        .setRetryAttemptExtension("knativeerrorattempt")
+                                        .build())
+                                .build()),
+                Tags.empty(),
+                "knativeerrorattempt");
+
+        sender
+                .send(event)
+                .onFailure(context::failNow)
+                .onSuccess(v -> context.verify(() -> {
+                    assertThat(attempts).containsExactly("1", "2", "3");
+                    sender.close().onSuccess(c -> context.completeNow());
+                }));
+    }
+
+    @Test
+    @Timeout(value = 20000)
+    public void shouldNotSetRetryAttemptExtensionByDefault(final Vertx vertx, final VertxTestContext context)
+            throws ExecutionException, InterruptedException {
+
+        final var port = 12350;
+        final var event = CloudEventBuilder.v1()
+                .withId(UUID.randomUUID().toString())
+                .withSource(URI.create("/api/v1/orders"))
+                .withType("dev.knative.eventing.created")
+                .build();
+
+        final var attempts = new CopyOnWriteArrayList<String>();
+
+        vertx.createHttpServer()
+                .requestHandler(r -> {
+                    attempts.add(r.getHeader("Ce-Knativeerrorattempt"));
+                    if (attempts.size() == 2) {
+                        r.response().setStatusCode(200).end();
+                    } else {
+                        r.response().setStatusCode(500).end();
+                    }
+                })
+                .listen(port, "localhost")
+                .toCompletionStage()
+                .toCompletableFuture()
+                .get();
+
+        final var sender = new WebClientCloudEventSender(
+                vertx,
+                WebClient.create(vertx),
+                "http://localhost:" + port,
+                "",
+                new NamespacedName("", ""),
+                FakeConsumerVerticleContext.get(
+                        FakeConsumerVerticleContext.get().getResource(),
+                        DataPlaneContract.Egress.newBuilder(
+                                        FakeConsumerVerticleContext.get().getEgress())
+                                .setEgressConfig(DataPlaneContract.EgressConfig.newBuilder()
+                                        .setBackoffDelay(100L)
+                                        .setTimeout(1000L)
+                                        .setBackoffPolicy(DataPlaneContract.BackoffPolicy.Linear)
+                                        .setRetry(1)
+                                        .build())
+                                .build()),
+                Tags.empty());
+
+        sender
+                .send(event)
+                .onFailure(context::failNow)
+                .onSuccess(v -> context.verify(() -> {
+                    assertThat(attempts).hasSize(2).containsOnlyNulls();
+                    sender.close().onSuccess(c -> context.completeNow());
+                }));
+    }
+
+    @Test
+    @Timeout(value = 20000)
+    public void shouldNotSetRetryAttemptExtensionWhenNotEnabled(final Vertx vertx, final VertxTestContext context)
+            throws ExecutionException, InterruptedException {
+
+        final var port = 12351;
+        final var event = CloudEventBuilder.v1()
+                .withId(UUID.randomUUID().toString())
+                .withSource(URI.create("/api/v1/orders"))
+                .withType("dev.knative.eventing.created")
+                .build();
+
+        final var attempts = new CopyOnWriteArrayList<String>();
+
+        vertx.createHttpServer()
+                .requestHandler(r -> {
+                    attempts.add(r.getHeader("Ce-Knativeerrorattempt"));
+                    if (attempts.size() == 2) {
+                        r.response().setStatusCode(200).end();
+                    } else {
+                        r.response().setStatusCode(500).end();
+                    }
+                })
+                .listen(port, "localhost")
+                .toCompletionStage()
+                .toCompletableFuture()
+                .get();
+
+        // Senders other than the subscriber sender, like the dead letter sink sender, don't enable the extension
+        // even when it's set in the egress config.
+        final var sender = new WebClientCloudEventSender(
+                vertx,
+                WebClient.create(vertx),
+                "http://localhost:" + port,
+                "",
+                new NamespacedName("", ""),
+                FakeConsumerVerticleContext.get(
+                        FakeConsumerVerticleContext.get().getResource(),
+                        DataPlaneContract.Egress.newBuilder(
+                                        FakeConsumerVerticleContext.get().getEgress())
+                                .setEgressConfig(DataPlaneContract.EgressConfig.newBuilder()
+                                        .setBackoffDelay(100L)
+                                        .setTimeout(1000L)
+                                        .setBackoffPolicy(DataPlaneContract.BackoffPolicy.Linear)
+                                        .setRetry(1)
+                                        .setRetryAttemptExtension("knativeerrorattempt")
+                                        .build())
+                                .build()),
+                Tags.empty());
+
+        sender
+                .send(event)
+                .onFailure(context::failNow)
+                .onSuccess(v -> context.verify(() -> {
+                    assertThat(attempts).hasSize(2).containsOnlyNulls();
+                    sender.close().onSuccess(c -> context.completeNow());
+                }));
+    }
+
     @Test
     @Timeout(value = 20000)
     public void shouldNotRetry(final Vertx vertx, final VertxTestContext context)
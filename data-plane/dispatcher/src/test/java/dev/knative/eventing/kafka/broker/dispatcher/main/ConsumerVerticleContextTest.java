@@ -0,0 +1,99 @@
+/*
+ * Copyright © 2018 Knative Authors (knative-dev@googlegroups.com)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dev.knative.eventing.kafka.broker.dispatcher.main;
+
+import static org.assertj.core.api.Assertions.assertThat;
+
+import dev.knative.eventing.kafka.broker.contract.DataPlaneContract;
+import dev.knative.eventing.kafka.broker.core.metrics.Metrics;
+import dev.knative.eventing.kafka.broker.core.reconciler.EgressContext;
+import dev.knative.eventing.kafka.broker.core.testing.CoreObjects;
+import java.util.HashMap;
+import java.util.List;
+import java.util.Map;
+import java.util.Set;
+import org.apache.kafka.clients.consumer.ConsumerConfig;
+import org.apache.kafka.clients.consumer.CooperativeStickyAssignor;
+import org.apache.kafka.clients.consumer.RangeAssignor;
+import org.apache.kafka.clients.consumer.StickyAssignor;
+import org.junit.jupiter.api.Test;
+
+public class ConsumerVerticleContextTest {
+
+    private static final String STICKY = StickyAssignor.class.getName();
+    private static final String COOPERATIVE_STICKY = CooperativeStickyAssignor.class.getName();
+
+    @Test
+    public void shouldNotChangeAssignorsWhenProtocolIsUnspecified() {
+        final var context = context(CoreObjects.egress1());
+
+        assertThat(context.getConsumerConfigs().get(ConsumerConfig.PARTITION_ASSIGNMENT_STRATEGY_CONFIG))
+                .isEqualTo(STICKY);
+    }
+
+    @Test
+    public void shouldChangeAssignorsWhenProtocolIsSet() {
+        final var context = context(DataPlaneContract.Egress.newBuilder(CoreObjects.egress1())
+                .setRebalanceProtocol(DataPlaneContract.RebalanceProtocol.COOPERATIVE)
+                .build());
+
+        assertThat(context.getConsumerConfigs().get(ConsumerConfig.PARTITION_ASSIGNMENT_STRATEGY_CONFIG))
+                .isEqualTo(COOPERATIVE_STICKY);
+    }
+
+    @Test
+    public void shouldPreferConfiguredAssignorWithEagerProtocol() {
+        assertThat(ConsumerVerticleContext.assignmentStrategies(STICKY, DataPlaneContract.RebalanceProtocol.EAGER))
+                .isEqualTo(STICKY + "," + COOPERATIVE_STICKY);
+    }
+
+    @Test
+    public void shouldDropEagerAssignorsWithCooperativeProtocol() {
+        assertThat(ConsumerVerticleContext.assignmentStrategies(
+                        STICKY, DataPlaneContract.RebalanceProtocol.COOPERATIVE))
+                .isEqualTo(COOPERATIVE_STICKY);
+        assertThat(ConsumerVerticleContext.assignmentStrategies(
+                        RangeAssignor.class.getName() + ", " + COOPERATIVE_STICKY,
+                        DataPlaneContract.RebalanceProtocol.COOPERATIVE))
+                .isEqualTo(COOPERATIVE_STICKY);
+    }
+
+    @Test
+    public void shouldKeepEagerAssignorFirstWithEagerProtocol() {
+        assertThat(ConsumerVerticleContext.assignmentStrategies(
+                        List.of(COOPERATIVE_STICKY), DataPlaneContract.RebalanceProtocol.EAGER))
+                .isEqualTo(RangeAssignor.class.getName() + "," + COOPERATIVE_STICKY);
+        assertThat(ConsumerVerticleContext.assignmentStrategies(
+                        List.of(COOPERATIVE_STICKY, StickyAssignor.class), DataPlaneContract.RebalanceProtocol.EAGER))
+                .isEqualTo(STICKY + "," + COOPERATIVE_STICKY);
+    }
+
+    @Test
+    public void shouldUseKafkaDefaultAssignorsWhenNoAssignorIsConfigured() {
+        assertThat(ConsumerVerticleContext.assignmentStrategies(null, DataPlaneContract.RebalanceProtocol.EAGER))
+                .isEqualTo(RangeAssignor.class.getName() + "," + COOPERATIVE_STICKY);
+        assertThat(ConsumerVerticleContext.assignmentStrategies(null, DataPlaneContract.RebalanceProtocol.COOPERATIVE))
+                .isEqualTo(COOPERATIVE_STICKY);
+    }
+
+    private static ConsumerVerticleContext context(final DataPlaneContract.Egress egress) {
+        return new ConsumerVerticleContext()
+                .withProducerConfigs(new HashMap<>())
+                .withConsumerConfigs(Map.of(ConsumerConfig.PARTITION_ASSIGNMENT_STRATEGY_CONFIG, STICKY))
+                .withMeterRegistry(Metrics.getRegistry())
+                .withResource(new EgressContext(CoreObjects.resource1(), egress, Set.of()));
+    }
+}